@@ -0,0 +1,121 @@
+package mempass
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Maximum number of passwords generated to find one that is far enough from the previous ones
+const maxBatchAttempts = 1000
+
+// Generate `n` distinct passwords. With `MinEditDistance`, any 2 passwords also differ
+// by at least that many insertions, deletions or substitutions of a character
+func (g *Generator) GenPasswords(n int) ([]string, error) {
+	pwds, _, err := g.genPasswords(n, false)
+
+	return pwds, err
+}
+
+// Generate `n` distinct passwords, like `GenPasswords`, and report the entropy of each one
+func (g *Generator) GenPasswordsReport(n int) ([]string, []*EntropyReport, error) {
+	return g.genPasswords(n, true)
+}
+
+func (g *Generator) genPasswords(n int, withEntropy bool) ([]string, []*EntropyReport, error) {
+	if n < 0 {
+		return nil, nil, errors.New("The number of passwords cannot be negative")
+	}
+
+	// Nothing is preallocated from `n`, a huge `n` only fails once the passwords run out
+	pwds := []string{}
+	reports := []*EntropyReport{}
+	seen := make(map[string]bool)
+
+	// Runes of the passwords, only needed to compute edit distances
+	var runes [][]rune
+
+	for len(pwds) < n {
+		attempt := 0
+
+		for ; attempt < maxBatchAttempts; attempt++ {
+			pwd, report, err := g.genPassword(withEntropy)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if seen[pwd] {
+				continue
+			}
+
+			if g.opt.MinEditDistance > 1 {
+				r := toRunes(pwd)
+				if !isFarFrom(r, runes, int(g.opt.MinEditDistance)) {
+					continue
+				}
+
+				runes = append(runes, r)
+			}
+
+			seen[pwd] = true
+			pwds = append(pwds, pwd)
+			reports = append(reports, report)
+
+			break
+		}
+
+		if attempt == maxBatchAttempts {
+			return nil, nil, fmt.Errorf("Cannot generate %d distinct passwords with these options, only %d were found", n, len(pwds))
+		}
+	}
+
+	return pwds, reports, nil
+}
+
+// Check that the edit distance between `pwd` and every password of `others` is at least `min`
+func isFarFrom(pwd []rune, others [][]rune, min int) bool {
+	for _, other := range others {
+		if editDistance(pwd, other, min) < min {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Levenshtein distance between `a` and `b`. The computation stops as soon as the distance reaches `max`, `max` is returned then
+func editDistance(a, b []rune, max int) int {
+	if diff := len(a) - len(b); diff >= max || -diff >= max {
+		return max
+	}
+
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, cur[j])
+		}
+
+		// Distances never decrease from one row to the next
+		if rowMin >= max {
+			return max
+		}
+
+		prev, cur = cur, prev
+	}
+
+	return min(prev[len(b)], max)
+}
@@ -0,0 +1,305 @@
+// Command mempass generates human memorable passwords from the command line.
+//
+// Usage:
+//
+//	mempass [flags]
+//
+// Every field of `mempass.Options` has its own flag, run `mempass -h` to list them.
+// Flags are applied on top of the preset given with `-preset`, if any.
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/busyapi/mempass"
+)
+
+// Maximum number of passwords generated by a single run
+const maxCount = 10000
+
+// A password and its entropy, as written by the JSON and CSV formats
+type result struct {
+	Password string  `json:"password"`
+	Entropy  float64 `json:"entropy"`
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// Run the command and return its exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("mempass", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	// Options are only changed by the flags that are set, once the preset is loaded
+	var setters []func(opt *mempass.Options)
+	set := func(fn func(opt *mempass.Options)) {
+		setters = append(setters, fn)
+	}
+
+	uintFlag := func(name, usage string, field func(opt *mempass.Options) *uint) {
+		fs.Func(name, usage, func(s string) error {
+			n, err := strconv.ParseUint(s, 10, 0)
+			if err != nil {
+				return errors.New("must be a positive integer")
+			}
+
+			set(func(opt *mempass.Options) { *field(opt) = uint(n) })
+			return nil
+		})
+	}
+
+	floatFlag := func(name, usage string, field func(opt *mempass.Options) *float32) {
+		fs.Func(name, usage, func(s string) error {
+			f, err := strconv.ParseFloat(s, 32)
+			if err != nil {
+				return errors.New("must be a number")
+			}
+
+			set(func(opt *mempass.Options) { *field(opt) = float32(f) })
+			return nil
+		})
+	}
+
+	stringFlag := func(name, usage string, field func(opt *mempass.Options) *string) {
+		fs.Func(name, usage, func(s string) error {
+			set(func(opt *mempass.Options) { *field(opt) = s })
+			return nil
+		})
+	}
+
+	runeFlag := func(name, usage string, field func(opt *mempass.Options) *rune) {
+		fs.Func(name, usage, func(s string) error {
+			if utf8.RuneCountInString(s) != 1 {
+				return errors.New("must be a single character")
+			}
+
+			r, _ := utf8.DecodeRuneInString(s)
+			set(func(opt *mempass.Options) { *field(opt) = r })
+			return nil
+		})
+	}
+
+	preset := fs.String("preset", "", "Preset to start from, see -list-presets")
+	listPresets := fs.Bool("list-presets", false, "List the presets and exit")
+	count := fs.Uint("n", 1, "Number of passwords to generate, at most "+strconv.Itoa(maxCount))
+	format := fs.String("format", "text", "Output format: text, json or csv. json and csv include the entropy")
+	showEntropy := fs.Bool("entropy", false, "Show the entropy in text format")
+	dictFile := fs.String("dict", "", "Word list file, one word per line, or one \"<part of speech> <word>\" per line for the phrase mode")
+	seed := fs.Int64("seed", 0, "Seed for reproducible output. NEVER use it for real passwords")
+
+	stringFlag("mode", "Generation mode: dict, rand, numeric, passphrase, pattern or phrase (default dict)", func(o *mempass.Options) *string { return (*string)(&o.Mode) })
+	stringFlag("passphrase", "User passphrase, for the passphrase mode", func(o *mempass.Options) *string { return &o.Passphrase })
+	stringFlag("pattern", "Template of the password, for the pattern mode, e.g. Word-word-99-!", func(o *mempass.Options) *string { return &o.Pattern })
+	stringFlag("phrase", "Parts of speech of the words, for the phrase mode, e.g. \"number adjective plural_noun verb\" (default \"adjective noun verb adverb\")", func(o *mempass.Options) *string { return (*string)(&o.Phrase) })
+	uintFlag("words", "Number of words (default 3)", func(o *mempass.Options) *uint { return &o.WordCount })
+	uintFlag("min-word-length", "Minimum word length (default 6)", func(o *mempass.Options) *uint { return &o.MinWordLength })
+	uintFlag("max-word-length", "Maximum word length (default 8)", func(o *mempass.Options) *uint { return &o.MaxWordLength })
+	uintFlag("digits-before", "Number of digits before each word", func(o *mempass.Options) *uint { return &o.DigitsBefore })
+	uintFlag("digits-after", "Number of digits after each word", func(o *mempass.Options) *uint { return &o.DigitsAfter })
+	stringFlag("digit-pos", "Digits placement: word, start, end, separator, inside or spread (default word)", func(o *mempass.Options) *string { return (*string)(&o.DigitPos) })
+	uintFlag("digit-count", "Number of digits, if -digit-pos is not word (default 2)", func(o *mempass.Options) *uint { return &o.DigitCount })
+	stringFlag("cap", "Capitalization rule: none, all, alternate, word_alternate, first_letter, last_letter, all_but_first_letter, all_but_last_letter or random (default none)", func(o *mempass.Options) *string { return (*string)(&o.CapRule) })
+	floatFlag("cap-ratio", "Uppercase ratio, for the random capitalization rule (default 0.2)", func(o *mempass.Options) *float32 { return &o.CapRatio })
+	stringFlag("symb-rule", "Symbols rule: fixed or random (default random)", func(o *mempass.Options) *string { return (*string)(&o.SymbRule) })
+	uintFlag("symbols-before", "Number of symbols before each word", func(o *mempass.Options) *uint { return &o.SymbolsBefore })
+	uintFlag("symbols-after", "Number of symbols after each word", func(o *mempass.Options) *uint { return &o.SymbolsAfter })
+	stringFlag("symb-pos", "Symbols placement: word, start, end, between or inside (default word)", func(o *mempass.Options) *string { return (*string)(&o.SymbPos) })
+	uintFlag("symbol-count", "Number of symbols, if -symb-pos is not word (default 1)", func(o *mempass.Options) *uint { return &o.SymbolCount })
+	stringFlag("symbol-pool", "Symbols pool, for the random symbols rule", func(o *mempass.Options) *string { return &o.SymbolPool })
+	runeFlag("symbol", "Symbol, for the fixed symbols rule (default /)", func(o *mempass.Options) *rune { return &o.Symbol })
+	stringFlag("sep-rule", "Separator rule: none, fixed or random (default fixed)", func(o *mempass.Options) *string { return (*string)(&o.SepRule) })
+	stringFlag("separator-pool", "Separators pool, for the random separator rule", func(o *mempass.Options) *string { return &o.SeparatorPool })
+	runeFlag("separator", "Separator, for the fixed separator rule (default -)", func(o *mempass.Options) *rune { return &o.Separator })
+	stringFlag("pad-rule", "Padding rule: fixed or random", func(o *mempass.Options) *string { return (*string)(&o.PadRule) })
+	runeFlag("pad-symbol", "Padding symbol, for the fixed padding rule (default .)", func(o *mempass.Options) *rune { return &o.PadSymbol })
+	uintFlag("pad-length", "Password length to reach with padding", func(o *mempass.Options) *uint { return &o.PadLength })
+	uintFlag("max-length", "Maximum password length", func(o *mempass.Options) *uint { return &o.MaxLength })
+	floatFlag("l33t", "1337 coding ratio, between 0 and 1", func(o *mempass.Options) *float32 { return &o.L33tRatio })
+	stringFlag("l33t-profile", "1337 substitutions: digits or symbols (default digits)", func(o *mempass.Options) *string { return (*string)(&o.L33tProfile) })
+	fs.Func("min-entropy", "Minimum entropy in bits, the number of words is chosen to reach it", func(s string) error {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return errors.New("must be a number")
+		}
+
+		set(func(opt *mempass.Options) { opt.MinEntropy = f })
+		return nil
+	})
+	uintFlag("min-distance", "Minimum edit distance between the passwords", func(o *mempass.Options) *uint { return &o.MinEditDistance })
+	stringFlag("policy-fix", "How a password that doesn't meet the policy of the preset is fixed: regenerate or repair (default regenerate)", func(o *mempass.Options) *string { return (*string)(&o.PolicyFix) })
+	stringFlag("lang", "Language of the embedded dictionary: en, fr, de, es, it, pt or nl (default en)", func(o *mempass.Options) *string { return (*string)(&o.Language) })
+	fs.BoolFunc("strip-accents", "Replace accented letters by their base letter", func(s string) error {
+		b, err := strconv.ParseBool(s)
+		set(func(opt *mempass.Options) { opt.StripAccents = b })
+		return err
+	})
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return 2
+	}
+
+	if fs.NArg() > 0 {
+		fmt.Fprintf(stderr, "unexpected argument: %s\n", fs.Arg(0))
+		return 2
+	}
+
+	if *count > maxCount {
+		fmt.Fprintf(stderr, "-n must be at most %d\n", maxCount)
+		return 2
+	}
+
+	if *listPresets {
+		list, err := mempass.Presets()
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+
+		for _, p := range list {
+			fmt.Fprintf(stdout, "%-14s %s\n", p.Name, p.Description)
+		}
+
+		return 0
+	}
+
+	opt := &mempass.Options{}
+
+	if *preset != "" {
+		var err error
+		if opt, err = mempass.LoadPreset(*preset); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+
+	for _, fn := range setters {
+		fn(opt)
+	}
+
+	if *dictFile != "" {
+		dict, err := loadDictionary(*dictFile, opt.Mode == mempass.ModePhrase)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+
+		opt.Dictionary = dict
+	}
+
+	// 0 is a valid seed, so only the presence of the flag counts
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			opt.Random = mempass.NewSeededRandom(*seed)
+		}
+	})
+
+	if *format != "text" && *format != "json" && *format != "csv" {
+		fmt.Fprintf(stderr, "unknown format: %s\n", *format)
+		return 2
+	}
+
+	withEntropy := *showEntropy || *format != "text"
+	results := make([]result, 0, *count)
+	gen := mempass.NewGenerator(opt)
+
+	var pwds []string
+	var reports []*mempass.EntropyReport
+	var err error
+
+	// The passwords are distinct
+	if withEntropy {
+		pwds, reports, err = gen.GenPasswordsReport(int(*count))
+	} else {
+		pwds, err = gen.GenPasswords(int(*count))
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	for i, pwd := range pwds {
+		res := result{Password: pwd}
+		if withEntropy {
+			res.Entropy = reports[i].Bits
+		}
+
+		results = append(results, res)
+	}
+
+	if err := write(stdout, *format, *showEntropy, results); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	return 0
+}
+
+// Write the passwords in the requested format
+func write(w io.Writer, format string, showEntropy bool, results []result) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(results)
+
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"password", "entropy"})
+
+		for _, res := range results {
+			cw.Write([]string{res.Password, strconv.FormatFloat(res.Entropy, 'f', 2, 64)})
+		}
+
+		cw.Flush()
+
+		return cw.Error()
+	}
+
+	for _, res := range results {
+		var err error
+
+		if showEntropy {
+			_, err = fmt.Fprintf(w, "%s\t%.2f\n", res.Password, res.Entropy)
+		} else {
+			_, err = fmt.Fprintln(w, res.Password)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Load a word list file, tagged with parts of speech or not
+func loadDictionary(path string, tagged bool) (*mempass.Dictionary, error) {
+	if !tagged {
+		return mempass.LoadDictionaryFile(path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.New("Error opening dictionary: " + err.Error())
+	}
+
+	defer file.Close()
+
+	return mempass.NewTaggedDictionary(file)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestText(t *testing.T) {
+	out := testRun(t, 0, "-n", "3", "-words", "2", "-separator", "_", "-cap", "first_letter")
+
+	if !regexp.MustCompile(`^([A-Z][a-z]{5,7}_[A-Z][a-z]{5,7}\n){3}$`).MatchString(out) {
+		t.Errorf("got %q", out)
+	}
+
+	out = testRun(t, 0, "-preset", "pin", "-entropy")
+
+	if !regexp.MustCompile(`^\d{8}\t26\.58\n$`).MatchString(out) {
+		t.Errorf("got %q", out)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var results []result
+
+	out := testRun(t, 0, "-format", "json", "-seed", "42")
+	if err := json.Unmarshal([]byte(out), &results); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 || results[0].Password != "eyebrows-tinging-burses" || results[0].Entropy <= 0 {
+		t.Errorf("got %+v", results)
+	}
+}
+
+func TestSeedZero(t *testing.T) {
+	out := testRun(t, 0, "-seed", "0", "-n", "3")
+
+	if again := testRun(t, 0, "-seed", "0", "-n", "3"); again != out {
+		t.Errorf("got %q, then %q", out, again)
+	}
+}
+
+func TestCSV(t *testing.T) {
+	out := testRun(t, 0, "-format", "csv", "-n", "5", "-digit-pos", "end")
+
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 6 || records[0][0] != "password" || records[0][1] != "entropy" {
+		t.Errorf("got %q", records)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	testRun(t, 2, "-words", "x")
+	testRun(t, 2, "-format", "xml")
+	testRun(t, 2, "-separator", "ab")
+	testRun(t, 2, "-n", "10001")
+	testRun(t, 1, "-preset", "unknown")
+	testRun(t, 1, "-min-word-length", "9", "-max-word-length", "8")
+}
+
+func testRun(t *testing.T, code int, args ...string) string {
+	var stdout, stderr bytes.Buffer
+
+	if got := run(args, &stdout, &stderr); got != code {
+		t.Errorf("%q: got exit code %d, want %d: %s", args, got, code, stderr.String())
+	}
+
+	return stdout.String()
+}
@@ -0,0 +1,59 @@
+// Command mempassd serves the password generator over HTTP. See the server package for the endpoints.
+//
+// Usage:
+//
+//	mempassd [-addr 127.0.0.1:8080] [-max-count 100] [-max-body 65536] [-max-in-flight 64] [-generate-timeout 5s]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/busyapi/mempass/server"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8080", "Address to listen on")
+	maxCount := flag.Int("max-count", 100, "Maximum number of passwords generated by a request")
+	maxBody := flag.Int64("max-body", 64<<10, "Maximum size of a request body, in bytes")
+	maxInFlight := flag.Int("max-in-flight", 64, "Maximum number of requests served at the same time")
+	generateTimeout := flag.Duration("generate-timeout", 5*time.Second, "Maximum time spent generating the passwords of a request")
+	flag.Parse()
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           server.New(server.Config{MaxCount: *maxCount, MaxBodyBytes: *maxBody, MaxInFlight: *maxInFlight, GenerateTimeout: *generateTimeout}),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       time.Minute,
+		MaxHeaderBytes:    16 << 10,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+
+		shutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := srv.Shutdown(shutdown); err != nil {
+			log.Printf("shutdown: %v", err)
+		}
+	}()
+
+	log.Printf("listening on %s", *addr)
+
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+}
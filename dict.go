@@ -4,87 +4,448 @@ import (
 	"bufio"
 	"embed"
 	"errors"
-	"math/rand"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
-//go:embed wordsEn.txt
+//go:embed words*.txt tagged*.txt
 var embeddedFile embed.FS
 
-// Get random words from the dictionary file
-func getDictWords(opt *Options) ([][]rune, error) {
-	var words [][]rune
-	dict, err := readDictFile(opt)
-	if err != nil {
-		return nil, err
+// A dictionary embedded in the module, loaded on first use only
+type embeddedDict struct {
+	file   string
+	tag    language.Tag
+	tagged bool // Words are tagged with their part of speech
+	once   sync.Once
+	dict   *Dictionary
+	err    error
+}
+
+var embeddedDicts = map[Language]*embeddedDict{
+	LangEnglish:    {file: "wordsEn.txt", tag: language.English},
+	LangFrench:     {file: "wordsFr.txt", tag: language.French},
+	LangGerman:     {file: "wordsDe.txt", tag: language.German},
+	LangSpanish:    {file: "wordsEs.txt", tag: language.Spanish},
+	LangItalian:    {file: "wordsIt.txt", tag: language.Italian},
+	LangPortuguese: {file: "wordsPt.txt", tag: language.Portuguese},
+	LangDutch:      {file: "wordsNl.txt", tag: language.Dutch},
+}
+
+// Embedded dictionaries tagged with parts of speech, used by the phrase mode
+var embeddedTaggedDicts = map[Language]*embeddedDict{
+	LangEnglish: {file: "taggedEn.txt", tag: language.English, tagged: true},
+}
+
+// Letters that are not accented letters but still cannot be typed on every keyboard
+var letterFolds = strings.NewReplacer("ß", "ss", "æ", "ae", "œ", "oe", "ø", "o", "ł", "l", "đ", "d", "ð", "d", "þ", "th", "ı", "i")
+
+// A list of words used by the dictionary mode. It is immutable and safe for concurrent use
+type Dictionary struct {
+	index     *wordIndex
+	rejected  []string
+	plain     *Dictionary
+	plainOnce sync.Once
+	rank      map[string]int // Used by `Analyze`, built on first use
+	rankOnce  sync.Once
+	tags      map[PartOfSpeech][]string // Words of each part of speech, nil if the dictionary is not tagged
+}
+
+// Immutable index of words, sorted by length. It is safe for concurrent use
+type wordIndex struct {
+	words   []string // All words, sorted by length. Words of the same length keep their original order
+	offsets []int    // `offsets[l]` is the position in `words` of the first word of length `l`
+}
+
+// Load a dictionary from a reader. The expected format is one word per line.
+// Words are lowercased and deduplicated, blank lines and lines starting with `#` are ignored.
+// Lines of diceware lists such as the EFF long list (`11111	abacus`) are accepted, the dice roll is dropped.
+// Words containing anything else than letters are rejected, see `Rejected`
+func NewDictionary(r io.Reader) (*Dictionary, error) {
+	return newDictionary(r, language.Und)
+}
+
+// Load a dictionary from a reader, lowercasing words with the rules of the `tag` language
+func newDictionary(r io.Reader, tag language.Tag) (*Dictionary, error) {
+	var words, rejected []string
+	seen := make(map[string]bool)
+	lower := cases.Lower(tag)
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// Accented letters may be encoded in a composed or decomposed form, always use the composed one
+		word := lower.String(norm.NFC.String(dropDiceRoll(line)))
+
+		if !isWord(word) {
+			rejected = append(rejected, line)
+			continue
+		}
+
+		if seen[word] {
+			continue
+		}
+
+		seen[word] = true
+		words = append(words, word)
 	}
 
-	keys := make([]int, 0, len(dict))
+	if err := scanner.Err(); err != nil {
+		return nil, errors.New("Error while reading dictionary: " + err.Error())
+	}
 
-	for k := range dict {
-		keys = append(keys, k)
+	if len(words) == 0 {
+		return nil, errors.New("Dictionary does not contain any valid word")
 	}
 
-	for i := 0; i < int(opt.WordCount); i++ {
-		var dictIdx int
+	return &Dictionary{index: newWordIndex(words), rejected: rejected}, nil
+}
+
+// Load a dictionary tagged with parts of speech, for the phrase mode. The expected format is one `<part of speech> <word>` per line,
+// e.g. `adjective brave`, the parts of speech being `adjective`, `noun`, `plural_noun`, `verb` and `adverb`.
+// Verbs should be in the past tense, so they agree with singular and plural nouns. A word can have several parts of speech.
+// Words are lowercased and deduplicated, blank lines and lines starting with `#` are ignored.
+// Lines with an unknown part of speech or a word containing anything else than letters are rejected, see `Rejected`
+func NewTaggedDictionary(r io.Reader) (*Dictionary, error) {
+	return newTaggedDictionary(r, language.Und)
+}
+
+// Load a tagged dictionary from a reader, lowercasing words with the rules of the `tag` language
+func newTaggedDictionary(r io.Reader, tag language.Tag) (*Dictionary, error) {
+	var words, rejected []string
+	seen := make(map[string]bool)
+	tags := make(map[PartOfSpeech][]string)
+	tagged := make(map[PartOfSpeech]map[string]bool)
+	lower := cases.Lower(tag)
 
-		keyIdx := int(rand.Float32() * float32((len(keys) - 1)))
-		dictIdx = keys[keyIdx]
+	scanner := bufio.NewScanner(r)
 
-		if _, exists := dict[dictIdx]; !exists {
-			i--
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
 			continue
 		}
 
-		words = append(words, dict[dictIdx][rand.Intn(len(dict[dictIdx]))])
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !isTag(PartOfSpeech(fields[0])) {
+			rejected = append(rejected, line)
+			continue
+		}
+
+		pos, word := PartOfSpeech(fields[0]), lower.String(norm.NFC.String(fields[1]))
+
+		if !isWord(word) {
+			rejected = append(rejected, line)
+			continue
+		}
+
+		if tagged[pos] == nil {
+			tagged[pos] = make(map[string]bool)
+		}
+
+		if !tagged[pos][word] {
+			tagged[pos][word] = true
+			tags[pos] = append(tags[pos], word)
+		}
+
+		if !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
 	}
 
-	return words, nil
+	if err := scanner.Err(); err != nil {
+		return nil, errors.New("Error while reading dictionary: " + err.Error())
+	}
+
+	if len(words) == 0 {
+		return nil, errors.New("Dictionary does not contain any valid word")
+	}
+
+	return &Dictionary{index: newWordIndex(words), rejected: rejected, tags: tags}, nil
+}
+
+// Return the embedded dictionary of a language
+func LoadLanguageDictionary(lang Language) (*Dictionary, error) {
+	e, exists := embeddedDicts[lang]
+	if !exists {
+		return nil, errors.New("Unsupported language: " + string(lang))
+	}
+
+	return e.load()
+}
+
+// Return the embedded dictionary of a language tagged with parts of speech, see `NewTaggedDictionary`
+func LoadTaggedLanguageDictionary(lang Language) (*Dictionary, error) {
+	e, exists := embeddedTaggedDicts[lang]
+	if !exists {
+		return nil, errors.New("No tagged dictionary for language: " + string(lang))
+	}
+
+	return e.load()
+}
+
+// Load the dictionary on first call
+func (e *embeddedDict) load() (*Dictionary, error) {
+	e.once.Do(func() {
+		file, err := embeddedFile.Open(e.file)
+		if err != nil {
+			e.err = errors.New("Error reading dict file: " + err.Error())
+			return
+		}
+
+		defer file.Close()
+
+		if e.tagged {
+			e.dict, e.err = newTaggedDictionary(file, e.tag)
+		} else {
+			e.dict, e.err = newDictionary(file, e.tag)
+		}
+	})
+
+	return e.dict, e.err
 }
 
-// Read words from the dictionary file and store them in a map
-// The keys of the map are the line lengths
-func readDictFile(opt *Options) (map[int][][]rune, error) {
-	words := make(map[int][][]rune)
+// Load a dictionary from a file of a file system. See `NewDictionary` for the expected format
+func LoadDictionaryFS(fsys fs.FS, name string) (*Dictionary, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, errors.New("Error opening dictionary: " + err.Error())
+	}
+
+	defer file.Close()
 
-	// sourceFilePath, err := getCurrentSourceFilePath()
-	// if err != nil {
-	// 	return nil, errors.New("Error reading dict file: " + err.Error())
-	// }
-	//
-	// sourceFileDir := filepath.Dir(sourceFilePath)
+	return NewDictionary(file)
+}
 
-	//file, err := os.Open(sourceFileDir + "/wordsEn.txt")
-	file, err := embeddedFile.Open("wordsEn.txt")
+// Load a dictionary from a file path. See `NewDictionary` for the expected format
+func LoadDictionaryFile(path string) (*Dictionary, error) {
+	file, err := os.Open(path)
 	if err != nil {
-		return nil, errors.New("Error reading dict file: " + err.Error())
+		return nil, errors.New("Error opening dictionary: " + err.Error())
 	}
 
 	defer file.Close()
 
-	// Create a scanner to read lines from the file
-	scanner := bufio.NewScanner(file)
+	return NewDictionary(file)
+}
 
-	// Read lines and append them to the slice
-	for scanner.Scan() {
-		line := scanner.Text()
-		runes := toRunes(line)
-		lc := len(runes)
+// Number of words in the dictionary
+func (d *Dictionary) Len() int {
+	return len(d.index.words)
+}
 
-		// Don't include words that are bellow `minWl` or above `maxWl`
-		if lc < int(opt.MinWordLength) || (opt.MaxWordLength > 0 && lc > int(opt.MaxWordLength)) {
+// Lines that were rejected while loading the dictionary because they are not a valid word
+func (d *Dictionary) Rejected() []string {
+	return d.rejected
+}
+
+// Return a copy of the dictionary where accented letters are replaced by their base letter, e.g. `é` by `e`.
+// Words that still contain letters outside of the a-z range are dropped, so every word can be typed on any keyboard.
+// The copy is built on first call only
+func (d *Dictionary) WithoutAccents() *Dictionary {
+	d.plainOnce.Do(func() {
+		d.plain = &Dictionary{index: newWordIndex(stripAccents(d.index.words)), rejected: d.rejected}
+
+		if d.tags != nil {
+			d.plain.tags = make(map[PartOfSpeech][]string, len(d.tags))
+			for pos, words := range d.tags {
+				d.plain.tags[pos] = stripAccents(words)
+			}
+		}
+	})
+
+	return d.plain
+}
+
+// Replace the accented letters of the words by their base letter, dropping the words that still contain letters outside of the a-z range
+func stripAccents(words []string) []string {
+	var plainWords []string
+	seen := make(map[string]bool)
+	strip := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+	for _, word := range words {
+		plain, _, err := transform.String(strip, word)
+		if err != nil {
 			continue
 		}
 
-		// Create a copy of the line and append it to the slice
-		lineCopy := make([]rune, len(line))
-		copy(lineCopy, runes)
-		words[lc] = append(words[lc], lineCopy)
+		plain = letterFolds.Replace(plain)
+
+		if strings.Trim(plain, ALPHABET_LOWER) != "" || seen[plain] {
+			continue
+		}
+
+		seen[plain] = true
+		plainWords = append(plainWords, plain)
 	}
 
-	// Check for any errors encountered during scanning
-	if err := scanner.Err(); err != nil {
-		return nil, errors.New("Error while scanning dict file: " + err.Error())
+	return plainWords
+}
+
+// Find the words of the dictionary contained in a string, in any case, even 1337 coded, e.g. `password` in `MyP4ssw0rd`.
+// Words shorter than 3 letters are ignored. The matches overlap, they are sorted by position
+func (d *Dictionary) Find(s string) []*Match {
+	ranks := d.ranks()
+	pwd := toRunes(s)
+
+	matches := wordMatches(pwd, func(word string) bool {
+		_, exists := ranks[word]
+		return exists
+	})
+
+	for _, m := range matches {
+		m.Guesses = matchGuesses(m, len(pwd), []*Dictionary{d})
+	}
+
+	return matches
+}
+
+// Drop the dice roll at the begining of a diceware list line
+func dropDiceRoll(line string) string {
+	fields := strings.Fields(line)
+
+	if len(fields) == 2 && strings.Trim(fields[0], NUMBERS) == "" {
+		return fields[1]
+	}
+
+	return line
+}
+
+// Check that a word is only made of letters
+func isWord(word string) bool {
+	for _, char := range word {
+		if !unicode.IsLetter(char) {
+			return false
+		}
+	}
+
+	return word != ""
+}
+
+// Build an index from a list of words
+func newWordIndex(words []string) *wordIndex {
+	maxLen := 0
+	lengths := make([]int, len(words))
+
+	for i, word := range words {
+		lengths[i] = len(toRunes(word))
+		if lengths[i] > maxLen {
+			maxLen = lengths[i]
+		}
+	}
+
+	// Count the words of each length, then turn the counts into offsets
+	offsets := make([]int, maxLen+2)
+	for _, l := range lengths {
+		offsets[l+1]++
+	}
+
+	for l := 1; l < len(offsets); l++ {
+		offsets[l] += offsets[l-1]
+	}
+
+	sorted := make([]string, len(words))
+	next := make([]int, len(offsets))
+	copy(next, offsets)
+
+	for i, word := range words {
+		sorted[next[lengths[i]]] = word
+		next[lengths[i]]++
+	}
+
+	return &wordIndex{words: sorted, offsets: offsets}
+}
+
+// Return the words whose length is between `min` and `max` included. `max` = 0 means no maximum
+// The returned slice is shared and must not be modified
+func (w *wordIndex) lookup(min, max uint) []string {
+	maxLen := uint(len(w.offsets) - 2)
+
+	if max == 0 || max > maxLen {
+		max = maxLen
+	}
+
+	if min > max {
+		return nil
+	}
+
+	return w.words[w.offsets[min]:w.offsets[max+1]]
+}
+
+// Get random words from the dictionary. If `budget` is not 0, the words don't have more than `budget` letters in total
+// Every eligible word has the same probability of being picked, whatever its length
+func getDictWords(opt *Options, rnd Random, budget uint) ([][]rune, error) {
+	var words [][]rune
+
+	index, err := getDictIndex(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := getDictPool(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	minLen := uint(len(toRunes(pool[0])))
+
+	for i := 0; i < int(opt.WordCount); i++ {
+		if budget > 0 {
+			pool = index.lookup(opt.MinWordLength, wordMaxLength(opt, budget, minLen, words))
+		}
+
+		words = append(words, toRunes(pool[rnd.Intn(len(pool))]))
 	}
 
 	return words, nil
 }
+
+// Get the index of the dictionary that matches the options
+func getDictIndex(opt *Options) (*wordIndex, error) {
+	dict := opt.Dictionary
+	if dict == nil {
+		var err error
+		if dict, err = LoadLanguageDictionary(opt.Language); err != nil {
+			return nil, err
+		}
+	}
+
+	if opt.StripAccents {
+		dict = dict.WithoutAccents()
+	}
+
+	return dict.index, nil
+}
+
+// Get the dictionary words that match the options
+func getDictPool(opt *Options) ([]string, error) {
+	index, err := getDictIndex(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := index.lookup(opt.MinWordLength, opt.MaxWordLength)
+
+	if len(pool) == 0 {
+		return nil, errors.New("No dictionary word matches `MinWordLength` and `MaxWordLength`")
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,383 @@
+package mempass
+
+import (
+	"math"
+	"sync"
+	"unicode"
+)
+
+var (
+	trigramOnce  sync.Once
+	trigramStart float64 // Entropy of the first 3 letters of a randomly generated word
+	trigramNext  float64 // Average entropy of each following letter of a randomly generated word
+)
+
+type EntropySource string
+
+const (
+	EntropyWords           EntropySource = "words"
+	EntropySeparators      EntropySource = "separators"
+	EntropyDigitsBefore    EntropySource = "digits_before"
+	EntropyDigitsAfter     EntropySource = "digits_after"
+	EntropyDigits          EntropySource = "digits"
+	EntropyDigitPositions  EntropySource = "digit_positions"
+	EntropySymbolsBefore   EntropySource = "symbols_before"
+	EntropySymbolsAfter    EntropySource = "symbols_after"
+	EntropySymbols         EntropySource = "symbols"
+	EntropySymbolPositions EntropySource = "symbol_positions"
+	EntropyPadding         EntropySource = "padding"
+	EntropyCapitalization  EntropySource = "capitalization"
+	EntropyL33t            EntropySource = "l33t"
+	EntropyPassphrase      EntropySource = "passphrase"
+	EntropyLetters         EntropySource = "letters" // Random consonants and vowels of a pattern
+)
+
+// Entropy added by one source of randomness
+type EntropyComponent struct {
+	Source EntropySource `json:"source"`
+	Bits   float64       `json:"bits"`
+}
+
+// Detail of the entropy of a generated password
+type EntropyReport struct {
+	Bits       float64            `json:"bits"`       // Total entropy
+	Components []EntropyComponent `json:"components"` // Entropy added by each option in use
+	Warnings   []string           `json:"warnings"`   // Options in use that don't add any entropy
+}
+
+func (r *EntropyReport) add(source EntropySource, bits float64) {
+	r.Components = append(r.Components, EntropyComponent{Source: source, Bits: bits})
+	r.Bits += bits
+}
+
+func (r *EntropyReport) warn(msg string) {
+	r.Warnings = append(r.Warnings, msg)
+}
+
+// Return the entropy added by `source`
+func (r *EntropyReport) Get(source EntropySource) float64 {
+	bits := 0.0
+
+	for _, c := range r.Components {
+		if c.Source == source {
+			bits += c.Bits
+		}
+	}
+
+	return bits
+}
+
+// Calculate the entropy of the random choices made to generate a password from `words`.
+// Words are the dictionary or randomly generated words, before any other processing. `paddingSize` is the number of padding characters added
+func (g *Generator) entropy(words [][]rune, paddingSize uint) (*EntropyReport, error) {
+	report := &EntropyReport{}
+
+	bits, err := g.wordsEntropy(words)
+	if err != nil {
+		return nil, err
+	}
+
+	report.add(EntropyWords, bits)
+
+	count := uint(len(words))
+
+	if g.opt.SepRule != SepRuleNone && count > 1 {
+		bits := g.separatorEntropy(count)
+		report.add(EntropySeparators, bits)
+
+		if bits == 0 {
+			report.warn("The separator is always the same and adds no entropy")
+		}
+	}
+
+	if g.opt.DigitsBefore > 0 {
+		report.add(EntropyDigitsBefore, float64(count*g.opt.DigitsBefore)*math.Log2(10))
+	}
+
+	if g.opt.DigitsAfter > 0 {
+		report.add(EntropyDigitsAfter, float64(count*g.opt.DigitsAfter)*math.Log2(10))
+	}
+
+	if digits := g.digitCount(count); g.opt.DigitPos != DigitPosWord && digits > 0 {
+		report.add(EntropyDigits, float64(digits)*math.Log2(10))
+
+		switch g.opt.DigitPos {
+		case DigitPosInside:
+			// The digits are inserted after the symbols
+			slots := wordsInnerSlots(words)
+			if g.opt.SymbPos == SymbPosInside {
+				slots += int(g.opt.SymbolCount)
+			}
+
+			report.add(EntropyDigitPositions, insideEntropy(slots, digits))
+
+		case DigitPosSpread:
+			report.add(EntropyDigitPositions, log2Binomial(int(count+digits)-1, int(digits)))
+		}
+	}
+
+	if symbols := g.symbolCount(count); symbols > 0 {
+		if g.opt.SymbPos != SymbPosWord {
+			report.add(EntropySymbols, float64(symbols)*g.symbolEntropy())
+		}
+
+		if g.opt.SymbolsBefore > 0 {
+			report.add(EntropySymbolsBefore, float64(count*g.opt.SymbolsBefore)*g.symbolEntropy())
+		}
+
+		if g.opt.SymbolsAfter > 0 {
+			report.add(EntropySymbolsAfter, float64(count*g.opt.SymbolsAfter)*g.symbolEntropy())
+		}
+
+		if g.opt.SymbPos == SymbPosInside {
+			report.add(EntropySymbolPositions, insideEntropy(wordsInnerSlots(words), symbols))
+		}
+
+		if g.symbolEntropy() == 0 {
+			report.warn("The symbol is always the same and adds no entropy")
+		}
+	}
+
+	if paddingSize > 0 {
+		bits := g.paddingEntropy(paddingSize)
+		report.add(EntropyPadding, bits)
+
+		if bits == 0 {
+			report.warn("The padding symbol is always the same and adds no entropy")
+		}
+	}
+
+	capBits, l33tBits := g.lettersEntropy(words)
+
+	if g.opt.CapRule != CapRuleNone {
+		report.add(EntropyCapitalization, capBits)
+
+		if g.opt.CapRule != CapRuleRandom {
+			report.warn("The capitalization rule `" + string(g.opt.CapRule) + "` is not random and adds no entropy")
+		}
+	}
+
+	if g.opt.L33tRatio > 0 {
+		report.add(EntropyL33t, l33tBits)
+
+		if g.opt.L33tRatio == 1 {
+			report.warn("All letters that can be 1337 coded are, this adds no entropy")
+		}
+	}
+
+	return report, nil
+}
+
+// Calculate the entropy of the random changes made to a passphrase
+func (g *Generator) passphraseEntropy(p *FromPassphrase) *EntropyReport {
+	report := &EntropyReport{}
+	report.add(EntropyPassphrase, p.entropy)
+	report.warn("The passphrase itself is not accounted, only the random changes made to it")
+
+	return report
+}
+
+// Entropy of the words choice. With `MaxLength`, each word is picked among the words that fit in the remaining budget
+func (g *Generator) wordsEntropy(words [][]rune) (float64, error) {
+	budget, err := g.letterBudget()
+	if err != nil {
+		return 0, err
+	}
+
+	if g.opt.Mode == ModePhrase {
+		return g.phrase.entropy(words, budget), nil
+	}
+
+	minLen, err := g.minWordLength()
+	if err != nil {
+		return 0, err
+	}
+
+	bits := 0.0
+
+	if g.opt.UseRand || g.opt.Mode == ModeRand {
+		for i, word := range words {
+			maxLen := g.opt.MaxWordLength
+			if budget > 0 {
+				maxLen = wordMaxLength(g.opt, budget, minLen, words[:i])
+			}
+
+			bits += math.Log2(float64(maxLen-g.opt.MinWordLength+1)) + trigramEntropy(len(word))
+		}
+
+		return bits, nil
+	}
+
+	if g.opt.Mode == ModeNumeric {
+		for i, word := range words {
+			maxLen := g.opt.MaxWordLength
+			if budget > 0 {
+				maxLen = wordMaxLength(g.opt, budget, minLen, words[:i])
+			}
+
+			bits += math.Log2(float64(maxLen-g.opt.MinWordLength+1)) + float64(len(word))*math.Log2(10)
+		}
+
+		return bits, nil
+	}
+
+	pool, err := getDictPool(g.opt)
+	if err != nil {
+		return 0, err
+	}
+
+	if budget == 0 {
+		return float64(len(words)) * math.Log2(float64(len(pool))), nil
+	}
+
+	index, err := getDictIndex(g.opt)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range words {
+		pool = index.lookup(g.opt.MinWordLength, wordMaxLength(g.opt, budget, minLen, words[:i]))
+		bits += math.Log2(float64(len(pool)))
+	}
+
+	return bits, nil
+}
+
+// Entropy of the separator choice. A single separator is picked for the whole password
+func (g *Generator) separatorEntropy(count uint) float64 {
+	if g.opt.SepRule != SepRuleRandom || count < 2 {
+		return 0
+	}
+
+	return poolEntropy(g.opt.SeparatorPool)
+}
+
+// Entropy of each symbol added before or after the words
+func (g *Generator) symbolEntropy() float64 {
+	if g.opt.Symbol != 0 {
+		return 0
+	}
+
+	return poolEntropy(g.opt.SymbolPool)
+}
+
+// Entropy of the positions of `count` characters inserted one by one at one of the `slots` inner positions of the words.
+// The positions of characters inserted next to each other cannot be told apart
+func insideEntropy(slots int, count uint) float64 {
+	k := int(count)
+
+	return log2Binomial(slots+k-1, k)
+}
+
+// Entropy of the padding
+func (g *Generator) paddingEntropy(paddingSize uint) float64 {
+	if g.opt.PadSymbol != 0 {
+		return 0
+	}
+
+	return float64(paddingSize) * poolEntropy(g.opt.SymbolPool)
+}
+
+// Entropy of the random capitalization and of the 1337 coding of the letters
+func (g *Generator) lettersEntropy(words [][]rune) (capBits float64, l33tBits float64) {
+	capRatio := 0.0
+	if g.opt.CapRule == CapRuleRandom {
+		capRatio = float64(g.opt.CapRatio)
+	}
+
+	for _, word := range words {
+		for _, char := range word {
+			if !unicode.IsLetter(char) {
+				continue
+			}
+
+			if g.opt.L33tRatio > 0 && g.l33t.can1337(char) {
+				// A 1337 coded letter loses its capitalization, and gets one of the candidates of the letter
+				l33tBits += binaryEntropy(float64(g.opt.L33tRatio)) + float64(g.opt.L33tRatio)*g.l33t.choiceEntropy(char)
+				capBits += (1 - float64(g.opt.L33tRatio)) * binaryEntropy(capRatio)
+			} else {
+				capBits += binaryEntropy(capRatio)
+			}
+		}
+	}
+
+	return capBits, l33tBits
+}
+
+// Entropy of a word of `wl` letters generated from the trigram table
+func trigramEntropy(wl int) float64 {
+	trigramOnce.Do(func() {
+		total := 0.0
+		var pairs [26][26]float64
+
+		for c1 := range trigram {
+			for c2 := range trigram[c1] {
+				for _, n := range trigram[c1][c2] {
+					pairs[c1][c2] += float64(n)
+				}
+				total += pairs[c1][c2]
+			}
+		}
+
+		for c1 := range trigram {
+			for c2 := range trigram[c1] {
+				for _, n := range trigram[c1][c2] {
+					if n == 0 {
+						continue
+					}
+
+					// The starting trigram is picked among all trigrams, the following letters
+					// are picked among the trigrams starting with the 2 previous letters
+					trigramStart -= float64(n) / total * math.Log2(float64(n)/total)
+					trigramNext -= float64(n) / total * math.Log2(float64(n)/pairs[c1][c2])
+				}
+			}
+		}
+	})
+
+	if wl <= 3 {
+		return trigramStart
+	}
+
+	return trigramStart + float64(wl-3)*trigramNext
+}
+
+// Entropy of a character picked in `pool`. A character appearing several times is more likely to be picked
+func poolEntropy(pool string) float64 {
+	runes := toRunes(pool)
+	counts := make(map[rune]int)
+
+	for _, char := range runes {
+		counts[char]++
+	}
+
+	bits := 0.0
+	for _, n := range counts {
+		p := float64(n) / float64(len(runes))
+		bits -= p * math.Log2(p)
+	}
+
+	return bits
+}
+
+// Entropy of a random event of probability `p`
+func binaryEntropy(p float64) float64 {
+	if p <= 0 || p >= 1 {
+		return 0
+	}
+
+	return -p*math.Log2(p) - (1-p)*math.Log2(1-p)
+}
+
+// Number of ways to pick `k` items among `n`, in bits
+func log2Binomial(n, k int) float64 {
+	if k <= 0 || k >= n {
+		return 0
+	}
+
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+
+	return (a - b - c) / math.Ln2
+}
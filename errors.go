@@ -0,0 +1,32 @@
+package mempass
+
+import "errors"
+
+// Reasons of the `OptionError` errors. Use `errors.Is` to know why an option is rejected
+var (
+	ErrUnsupportedValue = errors.New("option value is not supported")
+	ErrOutOfRange       = errors.New("option value is out of range")
+	ErrConflict         = errors.New("option conflicts with another option")
+	ErrMissingOption    = errors.New("option is required")
+	ErrEmptyPool        = errors.New("option pool has no usable character")
+)
+
+// An invalid option, returned by the generator. Use `errors.As` to get the field
+type OptionError struct {
+	Field  string // Name of the field of `Options`, e.g. `MinWordLength`. Fields of the policy are prefixed with `Policy.`
+	Value  any    // Value of the field
+	Err    error  // One of the `Err*` option errors
+	Reason string // Why the value is rejected, e.g. "cannot be greater than 28"
+}
+
+func (e *OptionError) Error() string {
+	return "`" + e.Field + "` " + e.Reason
+}
+
+func (e *OptionError) Unwrap() error {
+	return e.Err
+}
+
+func optionError(field string, value any, err error, reason string) error {
+	return &OptionError{Field: field, Value: value, Err: err, Reason: reason}
+}
@@ -1,43 +1,205 @@
 package mempass
 
-import "unicode"
+import (
+	"math"
+	"slices"
+	"strconv"
+	"unicode"
+)
+
+// Maximum number of readings returned by `Decode`. Characters that would add more readings get their first reading only
+const maxL33tReadings = 64
+
+// Letters that 1337 characters are commonly read as
+var l33tReadings = map[rune][]rune{
+	'4': {'a'}, '@': {'a'},
+	'8': {'b'},
+	'(': {'c'}, '{': {'c'}, '[': {'c'}, '<': {'c'},
+	'3': {'e'},
+	'6': {'g'}, '9': {'g'},
+	'1': {'i', 'l'}, '!': {'i'}, '|': {'i', 'l'},
+	'0': {'o'},
+	'5': {'s'}, '$': {'s'},
+	'7': {'t', 'l'}, '+': {'t'},
+	'%': {'x'},
+	'2': {'z'},
+}
+
+// Built-in 1337 substitution table
+type L33tProfile string
+
+const (
+	L33tProfileDigits  L33tProfile = "digits"  // Letters are replaced by digits only, e.g. `a` by `4`
+	L33tProfileSymbols L33tProfile = "symbols" // Letters are replaced by digits or symbols, e.g. `a` by `4` or `@`
+)
+
+// 1337 substitution table: the candidates of each lowercase letter, e.g. `'a': "4@"`.
+// The candidates must be distinct characters that are not letters
+type L33tTable map[rune]string
+
+// Candidates of each letter, by profile
+var l33tProfiles = map[L33tProfile]L33tTable{
+	L33tProfileDigits: {
+		'a': "4", 'b': "8", 'e': "3", 'g': "96", 'i': "1", 'l': "1", 'o': "0", 's': "5", 't': "7", 'z': "2",
+	},
+	L33tProfileSymbols: {
+		'a': "4@", 'b': "8", 'c': "(<", 'e': "3", 'g': "96", 'i': "1!", 'l': "1|", 'o': "0", 's': "5$", 't': "7+", 'x': "%", 'z': "2",
+	},
+}
 
 type L33t struct {
-	leetMap map[rune]rune
+	table    map[rune][]rune // Candidates of each lowercase letter
+	readings map[rune][]rune // Letters each candidate is read as
 }
 
+// Create a 1337 coder with the digits profile
 func NewL33t() *L33t {
-	leetMap := make(map[rune]rune)
-	leetMap['a'] = '4'
-	leetMap['A'] = '4'
-	leetMap['e'] = '3'
-	leetMap['E'] = '3'
-	leetMap['i'] = '1'
-	leetMap['I'] = '1'
-	leetMap['o'] = '0'
-	leetMap['O'] = '0'
-	leetMap['s'] = '5'
-	leetMap['S'] = '5'
-	leetMap['t'] = '7'
-	leetMap['T'] = '7'
-
-	return &L33t{leetMap}
+	return newL33t(l33tProfiles[L33tProfileDigits])
+}
+
+// Create a 1337 coder from a table. Its candidates are also read back by `Decode`
+func newL33t(table L33tTable) *L33t {
+	l := &L33t{table: make(map[rune][]rune, len(table)), readings: make(map[rune][]rune, len(l33tReadings))}
+
+	for char, letters := range l33tReadings {
+		l.readings[char] = letters
+	}
+
+	for letter, candidates := range table {
+		l.table[letter] = toRunes(candidates)
+
+		for _, char := range l.table[letter] {
+			if !slices.Contains(l.readings[char], letter) {
+				l.readings[char] = append(slices.Clip(l.readings[char]), letter)
+			}
+		}
+	}
+
+	return l
+}
+
+func (table L33tTable) check() error {
+	for letter, candidates := range table {
+		if !unicode.IsLower(letter) {
+			return optionError("L33tTable", table, ErrUnsupportedValue, "must only contain lowercase letters, got "+strconv.QuoteRune(letter))
+		}
+
+		if candidates == "" {
+			return optionError("L33tTable", table, ErrEmptyPool, "has no candidate for "+strconv.QuoteRune(letter))
+		}
+
+		seen := make(map[rune]bool)
+		for _, char := range candidates {
+			if unicode.IsLetter(char) || unicode.IsSpace(char) || seen[char] {
+				return optionError("L33tTable", table, ErrUnsupportedValue, "must map "+strconv.QuoteRune(letter)+" to distinct characters that are not letters or spaces")
+			}
+
+			seen[char] = true
+		}
+	}
+
+	return nil
 }
 
 func (l *L33t) can1337(char rune) bool {
-	if !unicode.IsLower((char)) {
+	if !unicode.IsLower(char) {
 		return false
 	}
 
-	_, exists := l.leetMap[char]
+	_, exists := l.table[char]
 
 	return exists
 }
 
-func (l *L33t) make1337(char rune, idx int) rune {
-	if _, exists := l.leetMap[char]; exists {
-		return l.leetMap[char]
+// Entropy of the choice of a candidate for a letter, 0 if it cannot be 1337 coded
+func (l *L33t) choiceEntropy(char rune) float64 {
+	if !l.can1337(char) {
+		return 0
+	}
+
+	return math.Log2(float64(len(l.table[char])))
+}
+
+// The lowercase letter with the least candidates, preferably one that cannot be 1337 coded
+func (l *L33t) weakestLetter() rune {
+	weakest := 'a'
+
+	for letter := 'a'; letter <= 'z'; letter++ {
+		if len(l.table[letter]) < len(l.table[weakest]) {
+			weakest = letter
+		}
+	}
+
+	return weakest
+}
+
+// Code a letter in any case with its first candidate
+func (l *L33t) make1337(char rune) rune {
+	if candidates := l.table[unicode.ToLower(char)]; len(candidates) > 0 {
+		return candidates[0]
 	}
 
 	return char
 }
+
+// Code a letter in any case with a random candidate
+func (l *L33t) makeRandom1337(char rune, rnd Random) rune {
+	candidates := l.table[unicode.ToLower(char)]
+
+	switch len(candidates) {
+	case 0:
+		return char
+	case 1:
+		return candidates[0]
+	}
+
+	return candidates[rnd.Intn(len(candidates))]
+}
+
+// Return the plausible lowercase readings of a 1337 coded string, e.g. `p4ssw0rd` is read `password`
+// and `1337` is read `ieet`, `ieel`, `leet` or `leel`. A character is read as the same letter everywhere in the string.
+// The string is returned lowercased if it has no 1337 character
+func (l *L33t) Decode(s string) []string {
+	readings := [][]rune{toRunes(s)}
+	for i, char := range readings[0] {
+		readings[0][i] = unicode.ToLower(char)
+	}
+
+	decoded := make(map[rune]bool)
+
+	for _, char := range toRunes(s) {
+		letters := l.readings[char]
+		if len(letters) == 0 || decoded[char] {
+			continue
+		}
+
+		decoded[char] = true
+
+		if len(readings)*len(letters) > maxL33tReadings {
+			letters = letters[:1]
+		}
+
+		next := make([][]rune, 0, len(readings)*len(letters))
+		for _, reading := range readings {
+			for _, letter := range letters {
+				r := append([]rune(nil), reading...)
+				for i := range r {
+					if r[i] == char {
+						r[i] = letter
+					}
+				}
+
+				next = append(next, r)
+			}
+		}
+
+		readings = next
+	}
+
+	result := make([]string, len(readings))
+	for i, reading := range readings {
+		result[i] = string(reading)
+	}
+
+	return result
+}
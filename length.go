@@ -0,0 +1,85 @@
+package mempass
+
+import "fmt"
+
+// Number of letters the words can use without exceeding `MaxLength`. 0 means no limit.
+// An error is returned if even the shortest words don't fit
+func (g *Generator) letterBudget() (uint, error) {
+	if g.opt.MaxLength == 0 || g.opt.Mode == ModePassphrase || g.opt.Mode == ModePattern {
+		return 0, nil
+	}
+
+	count := g.opt.WordCount
+	overhead := g.digitCount(count) + g.symbolCount(count)
+
+	if g.opt.SepRule != SepRuleNone && count > 1 {
+		overhead += count - 1
+	}
+
+	minLen, err := g.minWordLength()
+	if err != nil {
+		return 0, err
+	}
+
+	// The words of a phrase are at least as long as the shortest word of each part of speech
+	minLetters := count * minLen
+	if g.opt.Mode == ModePhrase {
+		minLetters = g.phrase.minLetters(0)
+	}
+
+	if need := overhead + minLetters; need > g.opt.MaxLength {
+		return 0, optionError("MaxLength", g.opt.MaxLength, ErrConflict, fmt.Sprintf("is too short, the options need at least %d characters", need))
+	}
+
+	return g.opt.MaxLength - overhead, nil
+}
+
+// Length of the shortest word that can be picked
+func (g *Generator) minWordLength() (uint, error) {
+	if g.opt.Mode == ModePhrase {
+		return g.phrase.minLen, nil
+	}
+
+	if g.opt.UseRand || g.opt.Mode == ModeRand {
+		// Randomly generated words have 3 letters at least
+		if g.opt.MinWordLength < 3 {
+			return 3, nil
+		}
+
+		return g.opt.MinWordLength, nil
+	}
+
+	if g.opt.Mode == ModeNumeric {
+		return g.opt.MinWordLength, nil
+	}
+
+	pool, err := getDictPool(g.opt)
+	if err != nil {
+		return 0, err
+	}
+
+	// The pool is sorted by length
+	return uint(len(toRunes(pool[0]))), nil
+}
+
+// Maximum length of the next word, so that the words already `picked` and the following ones,
+// at least `minLen` letters long, fit in `budget` letters
+func wordMaxLength(opt *Options, budget, minLen uint, picked [][]rune) uint {
+	used := uint(0)
+	for _, word := range picked {
+		used += uint(len(word))
+	}
+
+	// Never less than `minLen`, `letterBudget` made sure the shortest words fit
+	reserved := used + (opt.WordCount-uint(len(picked))-1)*minLen
+	if reserved+minLen > budget {
+		return minLen
+	}
+
+	max := budget - reserved
+	if opt.MaxWordLength > 0 && opt.MaxWordLength < max {
+		max = opt.MaxWordLength
+	}
+
+	return max
+}
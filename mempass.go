@@ -1,9 +1,9 @@
 package mempass
 
 import (
-	"errors"
-	"math"
-	"math/rand"
+	"fmt"
+	"maps"
+	"strings"
 	"unicode"
 )
 
@@ -12,12 +12,17 @@ type CapRule string
 type SepRule string
 type SymbRule string
 type SymbPos string
+type DigitPos string
 type PadRule string
+type Language string
 
 const (
 	ModeDict       Mode = "dict"
 	ModeRand       Mode = "rand"
 	ModePassphrase Mode = "passphrase"
+	ModeNumeric    Mode = "numeric" // Blocks of random digits instead of words
+	ModePattern    Mode = "pattern" // Password built from the template `Pattern`
+	ModePhrase     Mode = "phrase"  // Grammatical phrase of words tagged with their part of speech
 )
 
 const (
@@ -43,79 +48,248 @@ const (
 	SymbRuleRandom SymbRule = "random"
 )
 
+const (
+	SymbPosWord    SymbPos = "word"    // `SymbolsBefore` and `SymbolsAfter` symbols around each word
+	SymbPosStart   SymbPos = "start"   // `SymbolCount` symbols at the start of the password
+	SymbPosEnd     SymbPos = "end"     // `SymbolCount` symbols at the end of the password
+	SymbPosBetween SymbPos = "between" // `SymbolCount` symbols between each word
+	SymbPosInside  SymbPos = "inside"  // `SymbolCount` symbols, each at a random position inside a word
+)
+
+const (
+	DigitPosWord      DigitPos = "word"      // `DigitsBefore` and `DigitsAfter` digits around each word
+	DigitPosStart     DigitPos = "start"     // A block of `DigitCount` digits at the start of the password
+	DigitPosEnd       DigitPos = "end"       // A block of `DigitCount` digits at the end of the password
+	DigitPosSeparator DigitPos = "separator" // `DigitCount` digits between each word, instead of the separator
+	DigitPosInside    DigitPos = "inside"    // `DigitCount` digits, each at a random position inside a word
+	DigitPosSpread    DigitPos = "spread"    // `DigitCount` digits spread randomly at the end of the words
+)
+
 const (
 	PadRuleFixed  PadRule = "fixed"
 	PadRuleRandom PadRule = "random"
 )
 
+const (
+	LangEnglish    Language = "en"
+	LangFrench     Language = "fr"
+	LangGerman     Language = "de"
+	LangSpanish    Language = "es"
+	LangItalian    Language = "it"
+	LangPortuguese Language = "pt"
+	LangDutch      Language = "nl"
+)
+
 type Options struct {
-	Mode             Mode     // Generation mode. Default is `ModeDict`
-	Passphrase       string   // User passphrase. Only used if `Mode` is `passphrase`
-	UseRand          bool     // Deprecated: Use randomly generated words instead of dictionary words . Default false
-	WordCount        uint     // Number of words to generate. Using less than 2 is discouraged. Default is 3
-	MinWordLength    uint     // Minimum word length. O = no minimum. Using less than 4 is discouraged. Default is 6
-	MaxWordLength    uint     // Maximum word length. O = no maximum. Default is 8
-	DigitsAfter      uint     // Number of digits to add at the end of each word. Default is 0
-	DigitsBefore     uint     // Number of digits to add at the begining of each word. Default is 0
-	CapRule          CapRule  // Capitalization rule. Default is `CapRuleNone`
-	CapRatio         float32  // Uppercase ratio. 0.0 = no uppercase, 1.0 = all uppercase, 0.3 = 1/3 uppercase, etc. Only used if `CapRule` is `CapRandom`. Default is 0.2
-	SymbRule         SymbRule // Rule for adding symbols. Default is `SymbRuleNone`
-	SymbolsAfter     uint     // Number of symbols to add at the end of each word. Default is 0
-	SymbolsBefore    uint     // Number of symbols to add at the begining of each word. Default is 0
-	SymbolPool       string   // Symbols pool. Only used if `SymbRule` is `SymbRuleRandom`. Default is "@&!-_^$*%,.;:/=+"
-	Symbol           rune     // Symbol character. Only used if `SymbRule` is `SymbRuleFixed`. Default is `/`
-	SepRule          SepRule  // Seperator type. Default is `SepRuleFixed`
-	SeparatorPool    string   // Seperators pool. Only used if `SepRule` is `SepRuleRandom`. Default is "@&!-_^$*%,.;:/=+"
-	Separator        rune     // Separator for words. Only used if `SepRule` is `SepRuleFixed`. Default is '-'
-	PadRule          PadRule  // Padding rule. Ignored if `PadLength` is 0
-	PadSymbol        rune     // Padding symbol. Only used if `PadRule` si `PadRuleFixed`. Default is `.`
-	PadLength        uint     // Password length to reach with padding.
-	L33tRatio        float32  // 1337 coding ratio. 0.0 = no 1337, 1.0 = all 1337, 0.3 = 1/3 1337, etc`. Default is 0
-	CalculateEntropy bool     // Calculate entropy. Default is false
+	Mode             Mode        // Generation mode. Default is `ModeDict`
+	Passphrase       string      // User passphrase. Only used if `Mode` is `passphrase`
+	Pattern          string      // Template of the password, e.g. `Word-word-99-!`. Only used if `Mode` is `ModePattern`
+	Phrase           Phrase      // Parts of speech of the words. Only used if `Mode` is `ModePhrase`. Default is `PhraseAdjNounVerbAdv`
+	UseRand          bool        // Deprecated: Use randomly generated words instead of dictionary words . Default false
+	WordCount        uint        // Number of words to generate. Using less than 2 is discouraged. Default is 3
+	MinWordLength    uint        // Minimum word length. Using less than 4 is discouraged. Default is 6, use `WithWordLength` for no minimum
+	MaxWordLength    uint        // Maximum word length. Default is 8, use `WithWordLength` for no maximum
+	DigitsAfter      uint        // Number of digits to add at the end of each word. Default is 0
+	DigitsBefore     uint        // Number of digits to add at the begining of each word. Default is 0
+	DigitPos         DigitPos    // Where digits are added. Default is `DigitPosWord`
+	DigitCount       uint        // Number of digits to add. Only used if `DigitPos` is not `DigitPosWord`. Default is 2
+	CapRule          CapRule     // Capitalization rule. Default is `CapRuleNone`
+	CapRatio         float32     // Uppercase ratio. 0.0 = no uppercase, 1.0 = all uppercase, 0.3 = 1/3 uppercase, etc. Only used if `CapRule` is `CapRandom`. Default is 0.2
+	SymbRule         SymbRule    // Rule for adding symbols. Default is `SymbRuleNone`
+	SymbolsAfter     uint        // Number of symbols to add at the end of each word. Default is 0
+	SymbolsBefore    uint        // Number of symbols to add at the begining of each word. Default is 0
+	SymbPos          SymbPos     // Where symbols are added. Default is `SymbPosWord`
+	SymbolCount      uint        // Number of symbols to add. Only used if `SymbPos` is not `SymbPosWord`. Default is 1
+	SymbolPool       string      // Symbols pool. Only used if `SymbRule` is `SymbRuleRandom`. Default is "@&!-_^$*%,.;:/=+"
+	Symbol           rune        // Symbol character. Only used if `SymbRule` is `SymbRuleFixed`. Default is `/`
+	SepRule          SepRule     // Seperator type. Default is `SepRuleFixed`
+	SeparatorPool    string      // Seperators pool. Only used if `SepRule` is `SepRuleRandom`. Default is "@&!-_^$*%,.;:/=+"
+	Separator        rune        // Separator for words. Only used if `SepRule` is `SepRuleFixed`. Default is '-'
+	PadRule          PadRule     // Padding rule. Required if `PadLength` is set
+	PadSymbol        rune        // Padding symbol. Only used if `PadRule` si `PadRuleFixed`. Default is `.`
+	PadLength        uint        // Password length to reach with padding.
+	MaxLength        uint        // Maximum password length. Word lengths are chosen to fit, an error is returned if the options cannot fit. 0 = no maximum. Default is 0
+	L33tRatio        float32     // 1337 coding ratio. 0.0 = no 1337, 1.0 = all 1337, 0.3 = 1/3 1337, etc`. Default is 0
+	L33tProfile      L33tProfile // 1337 substitution table. Only used if `L33tRatio` is set. Default is `L33tProfileDigits`
+	L33tTable        L33tTable   // Custom 1337 substitution table. Overrides `L33tProfile`. Default is nil
+	CalculateEntropy bool        // Calculate entropy. Default is false
+	Random           Random      // Source of randomness. Use `NewSeededRandom` for reproducible output. Default is `NewSecureRandom()`
+	Dictionary       *Dictionary // Word list used if `Mode` is `ModeDict`. Default is the embedded dictionary of `Language`
+	MinEntropy       float64     // Minimum entropy in bits. If set, `WordCount` is ignored and the number of words, digits and symbols is chosen to reach it. Default is 0
+	Language         Language    // Language of the embedded dictionary. Ignored if `Dictionary` is set. Default is `LangEnglish`
+	MinEditDistance  uint        // Minimum edit distance between the passwords generated by `GenPasswords`. 0 or 1 = distinct passwords. Default is 0
+	Policy           *Policy     // Policy the password must meet. Default is nil
+	PolicyFix        PolicyFix   // How a password that doesn't meet `Policy` is fixed. Default is `PolicyFixRegenerate`
+	StripAccents     bool        // Replace accented letters by their base letter, e.g. `é` by `e`, so the password can be typed on any keyboard. Default is false
+
+	explicit optionFields // Fields set by the functional options of `New`, that keep their zero value instead of the default value
 }
 
+// Generator of passwords. Its options are frozen when it is created, so it can be reused
+// and called from several goroutines at the same time
 type Generator struct {
-	opt         *Options
-	words       [][]rune
-	size        uint
-	paddingSize uint
-	l33t        *L33t
+	opt     *Options
+	err     error
+	l33t    *L33t
+	pattern *pattern
+	phrase  *phrase
+	rnd     Random
 }
 
+// Create a generator. The options are copied and validated, later changes to `opt` have no effect.
+// If they are not valid, the error is returned by every call to the generator
 func NewGenerator(opt *Options) Generator {
-	if opt == nil {
-		opt = &Options{}
+	frozen := Options{}
+	if opt != nil {
+		frozen = *opt
+	}
+
+	if frozen.Policy != nil {
+		policy := *frozen.Policy
+		policy.BannedSubstrings = append([]string(nil), policy.BannedSubstrings...)
+		frozen.Policy = &policy
 	}
 
-	return Generator{opt: opt, l33t: NewL33t()}
+	frozen.L33tTable = maps.Clone(frozen.L33tTable)
+
+	rnd := frozen.Random
+	if rnd == nil {
+		rnd = NewSecureRandom()
+	}
+
+	g := Generator{opt: &frozen, l33t: NewL33t(), rnd: rnd}
+
+	if g.err = g.checkOptions(); g.err == nil && g.opt.MinEntropy > 0 && g.opt.Mode != ModePassphrase && g.opt.Mode != ModePattern {
+		g.err = g.fitEntropy()
+	}
+
+	return g
 }
 
 // Generate a human memorable password
 func (g *Generator) GenPassword() (string, float64, error) {
-	if err := g.checkOptions(); err != nil {
+	pwd, report, err := g.genPassword(g.opt.CalculateEntropy)
+	if err != nil {
 		return "", 0, err
 	}
 
+	ent := 0.0
+	if report != nil {
+		ent = report.Bits
+	}
+
+	return pwd, ent, nil
+}
+
+// Generate a human memorable password and report how each option contributes to the entropy
+func (g *Generator) GenPasswordReport() (string, *EntropyReport, error) {
+	return g.genPassword(true)
+}
+
+func (g *Generator) genPassword(withEntropy bool) (string, *EntropyReport, error) {
+	if g.err != nil {
+		return "", nil, g.err
+	}
+
+	if g.opt.Policy == nil {
+		return g.genOnce(withEntropy)
+	}
+
+	var err error
+
+	for i := 0; i < maxPolicyAttempts; i++ {
+		pwd, report, genErr := g.genOnce(withEntropy)
+		if genErr != nil {
+			return "", nil, genErr
+		}
+
+		// A repaired password longer than `MaxLength` is dropped
+		if g.opt.PolicyFix == PolicyFixRepair && g.opt.Policy.Check(pwd) != nil {
+			if repaired := g.repair(toRunes(pwd)); g.opt.MaxLength == 0 || uint(len(repaired)) <= g.opt.MaxLength {
+				pwd = string(repaired)
+			}
+		}
+
+		if err = g.opt.Policy.Check(pwd); err == nil {
+			if report != nil {
+				report.warn("Passwords that don't meet the policy are discarded or repaired, the actual entropy may be lower")
+			}
+
+			return pwd, report, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("%w after %d attempts: %w", ErrPolicyUnreachable, maxPolicyAttempts, err)
+}
+
+// Generate a single password, without checking the policy
+func (g *Generator) genOnce(withEntropy bool) (string, *EntropyReport, error) {
 	var pwd []rune
+	var report *EntropyReport
 
 	if g.opt.Mode == ModePassphrase {
-		p := NewFromPassphrase()
+		p := newFromPassphrase(g.rnd)
 		pwd = p.Generate(g.opt.Passphrase)
-		g.size = uint(len(pwd))
+
+		if g.opt.MaxLength > 0 && uint(len(pwd)) > g.opt.MaxLength {
+			return "", nil, fmt.Errorf("The passphrase is too long for `MaxLength`, %d characters are needed", len(pwd))
+		}
+
+		if withEntropy {
+			report = g.passphraseEntropy(p)
+		}
+	} else if g.opt.Mode == ModePattern {
+		pwd = g.genPattern()
+
+		if withEntropy {
+			report = g.patternEntropy()
+		}
 	} else {
 		var words [][]rune
-		var err error
+
+		budget, err := g.letterBudget()
+		if err != nil {
+			return "", nil, err
+		}
 
 		// Deprecated: don't use `UseRand` anymore
-		if !g.opt.UseRand || g.opt.Mode == ModeDict {
-			if words, err = getDictWords(g.opt); err != nil {
-				return "", 0, err
-			}
+		if g.opt.Mode == ModePhrase {
+			words = g.phraseWords(budget)
+		} else if g.opt.UseRand || g.opt.Mode == ModeRand {
+			words = genRandPwd(g.opt, g.rnd, budget)
+		} else if g.opt.Mode == ModeNumeric {
+			words = genNumericWords(g.opt, g.rnd, budget)
 		} else {
-			words = genRandPwd(g.opt)
+			if words, err = getDictWords(g.opt, g.rnd, budget); err != nil {
+				return "", nil, err
+			}
 		}
 
-		g.words = g.extraProcess(words)
+		processed := words
+		if g.opt.SymbPos == SymbPosInside {
+			symbol := func() rune { return g.padding(1, g.opt.Symbol, g.opt.SymbolPool)[0] }
+			if processed, err = g.insertInside(processed, g.opt.SymbolCount, symbol); err != nil {
+				return "", nil, err
+			}
+		}
+
+		if g.opt.DigitPos == DigitPosInside {
+			digit := func() rune { return g.randBytesFrom(1, NUMBERS)[0] }
+			if processed, err = g.insertInside(processed, g.opt.DigitCount, digit); err != nil {
+				return "", nil, err
+			}
+		}
+
+		processed = g.extraProcess(processed)
+
+		if g.opt.DigitPos == DigitPosSpread {
+			g.spreadDigits(processed)
+		}
+
+		size := uint(0)
+		for _, word := range processed {
+			size += uint(len(word))
+		}
 
 		var sep rune
 		if g.opt.SepRule != SepRuleNone {
@@ -125,41 +299,74 @@ func (g *Generator) GenPassword() (string, float64, error) {
 				sep = g.randBytesFrom(1, g.opt.SeparatorPool)[0]
 			}
 
-			g.size += uint(len(g.words) - 1)
+			size += uint(len(processed) - 1)
+		}
+
+		if g.opt.SymbPos != SymbPosWord && g.opt.SymbPos != SymbPosInside {
+			size += g.symbolCount(uint(len(processed)))
 		}
 
-		if g.opt.PadLength > 0 && g.size < g.opt.PadLength {
-			g.paddingSize = g.opt.PadLength - g.size
+		if g.opt.DigitPos == DigitPosStart || g.opt.DigitPos == DigitPosEnd || g.opt.DigitPos == DigitPosSeparator {
+			size += g.digitCount(uint(len(processed)))
 		}
 
-		pwd = make([]rune, g.size)
+		paddingSize := uint(0)
+		if g.opt.PadLength > 0 && size < g.opt.PadLength {
+			paddingSize = g.opt.PadLength - size
+		}
+
+		pwd = make([]rune, size)
 		idx := 0
 
-		for i, word := range g.words {
+		if g.opt.SymbPos == SymbPosStart {
+			idx += copy(pwd, g.padding(g.opt.SymbolCount, g.opt.Symbol, g.opt.SymbolPool))
+		}
+
+		if g.opt.DigitPos == DigitPosStart {
+			idx += copy(pwd[idx:], g.randBytesFrom(g.opt.DigitCount, NUMBERS))
+		}
+
+		for i, word := range processed {
 			copy(pwd[idx:], word)
 
 			idx += len(word)
 
-			if g.opt.SepRule != SepRuleNone {
-				if i < len(words)-1 {
+			if i < len(words)-1 {
+				if g.opt.SymbPos == SymbPosBetween {
+					idx += copy(pwd[idx:], g.padding(g.opt.SymbolCount, g.opt.Symbol, g.opt.SymbolPool))
+				}
+
+				if g.opt.SepRule != SepRuleNone {
 					pwd[idx] = sep
 					idx++
 				}
+
+				if g.opt.DigitPos == DigitPosSeparator {
+					idx += copy(pwd[idx:], g.randBytesFrom(g.opt.DigitCount, NUMBERS))
+				}
 			}
 		}
 
-		if g.paddingSize >= 1 {
-			pwd = g.addWordPadding(pwd, 0, g.paddingSize, g.opt.SymbolPool, g.opt.PadSymbol)
-			g.size += (g.opt.PadLength - g.size)
+		if g.opt.DigitPos == DigitPosEnd {
+			idx += copy(pwd[idx:], g.randBytesFrom(g.opt.DigitCount, NUMBERS))
 		}
-	}
 
-	ent := 0.0
-	if g.opt.CalculateEntropy {
-		ent = g.entropy(string(pwd))
+		if g.opt.SymbPos == SymbPosEnd {
+			copy(pwd[idx:], g.padding(g.opt.SymbolCount, g.opt.Symbol, g.opt.SymbolPool))
+		}
+
+		if paddingSize >= 1 {
+			pwd = g.addWordPadding(pwd, 0, paddingSize, g.opt.SymbolPool, g.opt.PadSymbol)
+		}
+
+		if withEntropy {
+			if report, err = g.entropy(words, paddingSize); err != nil {
+				return "", nil, err
+			}
+		}
 	}
 
-	return string(pwd), ent, nil
+	return string(pwd), report, nil
 }
 
 func (g *Generator) addNumsPadding(word []rune, nb uint, na uint) []rune {
@@ -206,7 +413,7 @@ func (g *Generator) randBytesFrom(count uint, source string) []rune {
 	runes := toRunes(source)
 
 	for i := 0; i < int(count); i++ {
-		idx := rand.Intn(len(runes))
+		idx := g.rnd.Intn(len(runes))
 		res[i] = runes[idx]
 	}
 
@@ -242,10 +449,9 @@ func (g *Generator) extraProcess(words [][]rune) [][]rune {
 		}
 
 		if g.opt.L33tRatio > 0 {
-			newWord = g.arrayMapIf(newWord, g.isRand, g.l33t.make1337, g.opt.L33tRatio)
+			newWord = g.arrayMapIf(newWord, g.isRand, g.make1337, g.opt.L33tRatio)
 		}
 
-		g.size += uint(len(newWord))
 		newWords[i] = newWord
 	}
 
@@ -313,7 +519,11 @@ func (g *Generator) isNotLastLetter(char rune, idx int, o ...any) bool {
 }
 
 func (g *Generator) isRand(char rune, idx int, o ...any) bool {
-	return rand.Float32() <= o[0].(float32)
+	return g.rnd.Float32() < o[0].(float32)
+}
+
+func (g *Generator) make1337(char rune, idx int) rune {
+	return g.l33t.makeRandom1337(char, g.rnd)
 }
 
 func (g *Generator) arrayMap(slice []rune, fn func(rune, int) rune) []rune {
@@ -346,44 +556,114 @@ func (g *Generator) checkOptions() error {
 		g.opt.Mode = "dict"
 	}
 
+	switch g.opt.Mode {
+	case ModeDict, ModeRand, ModeNumeric:
+	case ModePassphrase:
+		if g.opt.Passphrase == "" {
+			return optionError("Passphrase", g.opt.Passphrase, ErrMissingOption, "is required in passphrase mode")
+		}
+	case ModePattern:
+		if g.opt.Pattern == "" {
+			return optionError("Pattern", g.opt.Pattern, ErrMissingOption, "is required in pattern mode")
+		}
+	case ModePhrase:
+		if g.opt.Phrase == "" {
+			g.opt.Phrase = PhraseAdjNounVerbAdv
+		}
+	default:
+		return optionError("Mode", g.opt.Mode, ErrUnsupportedValue, "is not supported")
+	}
+
+	if g.opt.Language == "" {
+		g.opt.Language = LangEnglish
+	}
+
+	if _, exists := embeddedDicts[g.opt.Language]; !exists {
+		return optionError("Language", g.opt.Language, ErrUnsupportedValue, "is not supported")
+	}
+
 	if g.opt.WordCount == 0 {
+		if !g.opt.unset(fieldWordCount) && g.opt.MinEntropy == 0 {
+			return optionError("WordCount", g.opt.WordCount, ErrOutOfRange, "must be at least 1")
+		}
+
 		g.opt.WordCount = 3
 	}
 
-	if g.opt.MinWordLength == 0 {
+	// A phrase has one word per part of speech, whatever their length
+	if g.opt.Mode == ModePhrase {
+		var err error
+		if g.phrase, err = g.compilePhrase(); err != nil {
+			return err
+		}
+
+		g.opt.WordCount = uint(len(g.phrase.pools))
+	}
+
+	if g.opt.MinWordLength == 0 && g.opt.unset(fieldMinWordLength) {
 		g.opt.MinWordLength = 6
 	}
 
-	if g.opt.MaxWordLength == 0 {
+	if g.opt.MaxWordLength == 0 && g.opt.unset(fieldMaxWordLength) {
 		g.opt.MaxWordLength = 8
 	}
 
-	if g.opt.SeparatorPool == "" {
+	if g.opt.SeparatorPool == "" && g.opt.unset(fieldSeparatorPool) {
 		g.opt.SeparatorPool = "@&!-_^$*%,.;:/=+"
 	}
 
-	if g.opt.SymbolPool == "" {
+	if g.opt.SymbolPool == "" && g.opt.unset(fieldSymbolPool) {
 		g.opt.SymbolPool = "@&!-_^$*%,.;:/=+"
 	}
 
-	if g.opt.MinWordLength > 28 || g.opt.MaxWordLength > 28 {
-		return errors.New("`MinWordLength` and `MaxWordLength` cannot be greater than 28")
+	if g.opt.MinWordLength > 28 {
+		return optionError("MinWordLength", g.opt.MinWordLength, ErrOutOfRange, "cannot be greater than 28")
+	}
+
+	if g.opt.MaxWordLength > 28 {
+		return optionError("MaxWordLength", g.opt.MaxWordLength, ErrOutOfRange, "cannot be greater than 28")
+	}
+
+	// Generated words need bounds: random words have 3 letters at least, blocks of digits 1 digit
+	if g.opt.UseRand || g.opt.Mode == ModeRand || g.opt.Mode == ModeNumeric {
+		if g.opt.MaxWordLength == 0 {
+			g.opt.MaxWordLength = 28
+		}
+
+		if g.opt.Mode == ModeNumeric {
+			g.opt.MinWordLength = max(g.opt.MinWordLength, 1)
+		} else {
+			g.opt.MinWordLength = max(g.opt.MinWordLength, 3)
+		}
 	}
 
 	if g.opt.MinWordLength > 0 && g.opt.MaxWordLength > 0 && g.opt.MinWordLength > g.opt.MaxWordLength {
-		return errors.New("`MinWordLength` cannot be greater than `MaxWordLength`")
+		return optionError("MinWordLength", g.opt.MinWordLength, ErrConflict, "cannot be greater than `MaxWordLength`")
 	}
 
 	if g.opt.CapRule == "" {
 		g.opt.CapRule = CapRuleNone
 	}
 
-	if g.opt.CapRule == CapRuleRandom && g.opt.CapRatio == 0 {
+	switch g.opt.CapRule {
+	case CapRuleNone, CapRuleAll, CapRuleAlternate, CapRuleWordAlternate, CapRuleFirstLetter, CapRuleLastLetter,
+		CapRuleAllButFirstLetter, CapRuleAllButLastLetter, CapRuleRandom:
+	default:
+		return optionError("CapRule", g.opt.CapRule, ErrUnsupportedValue, "is not supported")
+	}
+
+	if g.opt.CapRule == CapRuleRandom && g.opt.CapRatio == 0 && g.opt.unset(fieldCapRatio) {
 		g.opt.CapRatio = .2
 	}
 
 	if g.opt.CapRule == CapRuleRandom && (g.opt.CapRatio <= 0 || g.opt.CapRatio >= 1) {
-		return errors.New("`CapRatio` must be between 0 and 1 excluded")
+		return optionError("CapRatio", g.opt.CapRatio, ErrOutOfRange, "must be between 0 and 1 excluded")
+	}
+
+	switch g.opt.SymbRule {
+	case "", SymbRuleFixed, SymbRuleRandom:
+	default:
+		return optionError("SymbRule", g.opt.SymbRule, ErrUnsupportedValue, "is not supported")
 	}
 
 	if g.opt.SymbRule == SymbRuleFixed && g.opt.Symbol == 0 {
@@ -394,10 +674,61 @@ func (g *Generator) checkOptions() error {
 		g.opt.Symbol = 0
 	}
 
+	if g.opt.SymbPos == "" {
+		g.opt.SymbPos = SymbPosWord
+	}
+
+	switch g.opt.SymbPos {
+	case SymbPosWord:
+	case SymbPosStart, SymbPosEnd, SymbPosBetween, SymbPosInside:
+		if g.opt.SymbolsBefore > 0 || g.opt.SymbolsAfter > 0 {
+			return optionError("SymbPos", g.opt.SymbPos, ErrConflict, "must be `SymbPosWord` to use `SymbolsBefore` and `SymbolsAfter`, use `SymbolCount` instead")
+		}
+
+		if g.opt.SymbolCount == 0 && g.opt.unset(fieldSymbolCount) {
+			g.opt.SymbolCount = 1
+		}
+	default:
+		return optionError("SymbPos", g.opt.SymbPos, ErrUnsupportedValue, "is not supported")
+	}
+
+	if g.opt.DigitPos == "" {
+		g.opt.DigitPos = DigitPosWord
+	}
+
+	switch g.opt.DigitPos {
+	case DigitPosWord:
+	case DigitPosStart, DigitPosEnd, DigitPosSeparator, DigitPosInside, DigitPosSpread:
+		if g.opt.DigitsBefore > 0 || g.opt.DigitsAfter > 0 {
+			return optionError("DigitPos", g.opt.DigitPos, ErrConflict, "must be `DigitPosWord` to use `DigitsBefore` and `DigitsAfter`, use `DigitCount` instead")
+		}
+
+		if g.opt.DigitCount == 0 && g.opt.unset(fieldDigitCount) {
+			g.opt.DigitCount = 2
+		}
+
+		// The digits are the separator
+		if g.opt.DigitPos == DigitPosSeparator {
+			if g.opt.SepRule != "" && g.opt.SepRule != SepRuleNone {
+				return optionError("DigitPos", g.opt.DigitPos, ErrConflict, "cannot be used with a separator, the digits are the separator")
+			}
+
+			g.opt.SepRule = SepRuleNone
+		}
+	default:
+		return optionError("DigitPos", g.opt.DigitPos, ErrUnsupportedValue, "is not supported")
+	}
+
 	if g.opt.SepRule == "" {
 		g.opt.SepRule = SepRuleFixed
 	}
 
+	switch g.opt.SepRule {
+	case SepRuleNone, SepRuleFixed, SepRuleRandom:
+	default:
+		return optionError("SepRule", g.opt.SepRule, ErrUnsupportedValue, "is not supported")
+	}
+
 	if g.opt.SepRule == SepRuleFixed && g.opt.Separator == 0 {
 		g.opt.Separator = '-'
 	}
@@ -406,73 +737,122 @@ func (g *Generator) checkOptions() error {
 		g.opt.Separator = 0
 	}
 
+	switch g.opt.PadRule {
+	case "":
+		if g.opt.PadLength > 0 {
+			return optionError("PadRule", g.opt.PadRule, ErrMissingOption, "is required if `PadLength` is set")
+		}
+	case PadRuleFixed, PadRuleRandom:
+	default:
+		return optionError("PadRule", g.opt.PadRule, ErrUnsupportedValue, "is not supported")
+	}
+
 	if g.opt.PadRule == PadRuleFixed && g.opt.PadSymbol == 0 {
 		g.opt.PadSymbol = '.'
 	}
 
-	if g.opt.L33tRatio < 0 || g.opt.L33tRatio > 1 {
-		return errors.New("`L33tRatio` must be between 0 and 1 included")
+	if g.opt.Mode == ModePattern {
+		var err error
+		if g.pattern, err = g.compilePattern(); err != nil {
+			return err
+		}
+
+		if g.opt.MaxLength > 0 && g.pattern.maxLen > g.opt.MaxLength {
+			return optionError("MaxLength", g.opt.MaxLength, ErrConflict, fmt.Sprintf("is too short for `Pattern`, up to %d characters are needed", g.pattern.maxLen))
+		}
 	}
 
-	return nil
-}
+	if g.opt.Policy != nil {
+		if g.opt.PolicyFix == "" {
+			g.opt.PolicyFix = PolicyFixRegenerate
+		}
 
-func (g *Generator) entropy(pass string) float64 {
-	charRange := 26
-	var usedSymbols string
+		if g.opt.PolicyFix != PolicyFixRegenerate && g.opt.PolicyFix != PolicyFixRepair {
+			return optionError("PolicyFix", g.opt.PolicyFix, ErrUnsupportedValue, "is not supported")
+		}
 
-	if g.opt.CapRule != CapRuleNone {
-		charRange *= 2
-	}
+		if g.opt.Policy.MaxLength > 0 && g.opt.Policy.MinLength > g.opt.Policy.MaxLength {
+			return optionError("Policy.MinLength", g.opt.Policy.MinLength, ErrConflict, "cannot be greater than `Policy.MaxLength`")
+		}
+
+		if g.opt.MaxLength > 0 && g.opt.Policy.MinLength > g.opt.MaxLength {
+			return optionError("Policy.MinLength", g.opt.Policy.MinLength, ErrConflict, "cannot be greater than `MaxLength`")
+		}
 
-	if g.opt.DigitsAfter > 0 || g.opt.DigitsBefore > 0 || g.opt.L33tRatio > 0 {
-		charRange += 10
+		if g.opt.Policy.MinClasses > 4 {
+			return optionError("Policy.MinClasses", g.opt.Policy.MinClasses, ErrOutOfRange, "cannot be greater than 4")
+		}
+
+		// The required characters must fit in the password
+		minChars := g.opt.Policy.MinLower + g.opt.Policy.MinUpper + g.opt.Policy.MinDigits + g.opt.Policy.MinSymbols
+		for _, limit := range []struct {
+			field string
+			value uint
+		}{{"Policy.MaxLength", g.opt.Policy.MaxLength}, {"MaxLength", g.opt.MaxLength}} {
+			if limit.value > 0 && minChars > limit.value {
+				return optionError(limit.field, limit.value, ErrConflict, fmt.Sprintf("is too short for the characters required by `Policy`, %d are needed", minChars))
+			}
+		}
 	}
 
-	if g.opt.SymbolsAfter > 0 || g.opt.SymbolsBefore > 0 {
-		if g.opt.SymbRule == SymbRuleFixed {
-			usedSymbols = string(g.opt.Symbol)
-		} else {
-			usedSymbols = g.opt.SymbolPool
+	for _, pool := range []struct {
+		field string
+		value string
+		used  bool
+	}{
+		{"SeparatorPool", g.opt.SeparatorPool, g.opt.SepRule == SepRuleRandom},
+		{"SymbolPool", g.opt.SymbolPool, g.opt.SymbRule == SymbRuleRandom || g.opt.PadRule == PadRuleRandom || g.pattern != nil && g.pattern.has(tokenSymbol)},
+	} {
+		if pool.used && pool.value == "" {
+			return optionError(pool.field, pool.value, ErrEmptyPool, "cannot be empty")
+		}
+
+		// Pools are emptied by the characters the policy forbids
+		if pool.used && g.opt.Policy != nil && !strings.ContainsFunc(pool.value, isAllowedBy(g.opt.Policy)) {
+			return optionError(pool.field, pool.value, ErrEmptyPool, "only contains characters forbidden by `Policy.ForbiddenChars`")
 		}
 	}
 
-	if g.opt.SepRule == SepRuleFixed {
-		usedSymbols = g.mergeStrings(usedSymbols, string(g.opt.Separator))
-	} else if g.opt.SepRule == SepRuleRandom {
-		usedSymbols = g.mergeStrings(usedSymbols, g.opt.SeparatorPool)
+	if g.opt.MaxLength > 0 && g.opt.PadLength > g.opt.MaxLength {
+		return optionError("PadLength", g.opt.PadLength, ErrConflict, "cannot be greater than `MaxLength`")
 	}
 
-	if g.opt.PadRule == PadRuleFixed {
-		usedSymbols = g.mergeStrings(usedSymbols, string(g.opt.PadSymbol))
-	} else if g.opt.PadRule == PadRuleRandom {
-		usedSymbols = g.mergeStrings(usedSymbols, g.opt.SymbolPool)
+	if g.opt.MinEntropy < 0 {
+		return optionError("MinEntropy", g.opt.MinEntropy, ErrOutOfRange, "cannot be negative")
 	}
 
-	charRange += len(usedSymbols)
-	len := float64(len(pass))
+	if g.pattern != nil && g.patternEntropy().Bits < g.opt.MinEntropy {
+		return optionError("MinEntropy", g.opt.MinEntropy, ErrConflict, "cannot be reached with `Pattern`")
+	}
 
-	return math.Log2(float64(math.Pow(float64(charRange), len)))
-}
+	// Only the random changes made to a passphrase add entropy
+	if g.opt.Mode == ModePassphrase && g.opt.MinEntropy > 0 {
+		if bits := newFromPassphrase(g.rnd).minEntropy(g.opt.Passphrase); bits < g.opt.MinEntropy {
+			return optionError("MinEntropy", g.opt.MinEntropy, ErrConflict, fmt.Sprintf("cannot be reached with `Passphrase`, its random changes add %.2f bits at least", bits))
+		}
+	}
 
-func (g *Generator) mergeStrings(dest, src string) string {
-	// Create a map to store characters in the destination string
-	destChars := make(map[rune]bool)
+	if g.opt.L33tRatio < 0 || g.opt.L33tRatio > 1 {
+		return optionError("L33tRatio", g.opt.L33tRatio, ErrOutOfRange, "must be between 0 and 1 included")
+	}
 
-	// Populate the map with characters from the destination string
-	for _, char := range dest {
-		destChars[char] = true
+	if g.opt.L33tProfile == "" {
+		g.opt.L33tProfile = L33tProfileDigits
 	}
 
-	// Create a result string
-	result := dest
+	if _, exists := l33tProfiles[g.opt.L33tProfile]; !exists {
+		return optionError("L33tProfile", g.opt.L33tProfile, ErrUnsupportedValue, "is not supported")
+	}
 
-	// Append characters from the source string that are not in the destination
-	for _, char := range src {
-		if !destChars[char] {
-			result += string(char)
+	if g.opt.L33tTable != nil {
+		if err := g.opt.L33tTable.check(); err != nil {
+			return err
 		}
+
+		g.l33t = newL33t(g.opt.L33tTable)
+	} else {
+		g.l33t = newL33t(l33tProfiles[g.opt.L33tProfile])
 	}
 
-	return result
+	return nil
 }
@@ -2,8 +2,16 @@ package mempass
 
 import (
 	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"testing/fstest"
 )
 
 func TestDefault(t *testing.T) {
@@ -93,6 +101,112 @@ func Test2SymbolsRandomSet(t *testing.T) {
 	}, `^([@&!]{2}[a-z]{6,8}[@&!]{2}){2}$`, t)
 }
 
+func TestSymbolStart(t *testing.T) {
+	testPwd(&Options{
+		WordCount:   2,
+		SymbPos:     SymbPosStart,
+		SymbolCount: 2,
+		SymbolPool:  "@&!",
+	}, `^[@&!]{2}[a-z]{6,8}-[a-z]{6,8}$`, t)
+}
+
+func TestSymbolEnd(t *testing.T) {
+	testPwd(&Options{
+		WordCount: 3,
+		SymbPos:   SymbPosEnd,
+		SymbRule:  SymbRuleFixed,
+	}, `^[a-z]{6,8}-[a-z]{6,8}-[a-z]{6,8}/$`, t)
+}
+
+func TestSymbolBetween(t *testing.T) {
+	testPwd(&Options{
+		WordCount:  3,
+		SymbPos:    SymbPosBetween,
+		SepRule:    SepRuleNone,
+		SymbolPool: "@&!",
+	}, `^[a-z]{6,8}[@&!][a-z]{6,8}[@&!][a-z]{6,8}$`, t)
+}
+
+func TestSymbolInside(t *testing.T) {
+	opt := &Options{
+		WordCount:   2,
+		SymbPos:     SymbPosInside,
+		SymbolCount: 3,
+		SymbolPool:  "@&!",
+	}
+
+	for i := 0; i < 100; i++ {
+		testPwd(opt, `^[a-z][a-z@&!]{4,9}[a-z]-[a-z][a-z@&!]{4,9}[a-z]$`, t)
+
+		gen := NewGenerator(opt)
+		pwd, _, _ := gen.GenPassword()
+		if n := strings.Count(pwd, "@") + strings.Count(pwd, "&") + strings.Count(pwd, "!"); n != 3 {
+			printError(fmt.Errorf("got %d symbols in %q", n, pwd), t)
+		}
+	}
+
+	gen := NewGenerator(&Options{SymbPos: SymbPosEnd, SymbolsAfter: 1})
+	if _, _, err := gen.GenPassword(); err == nil {
+		printError(errors.New("`SymbolsAfter` accepted with `SymbPosEnd`"), t)
+	}
+}
+
+func TestDigitBlock(t *testing.T) {
+	testPwd(&Options{
+		WordCount: 2,
+		DigitPos:  DigitPosEnd,
+	}, `^[a-z]{6,8}-[a-z]{6,8}\d{2}$`, t)
+
+	testPwd(&Options{
+		WordCount:  2,
+		DigitPos:   DigitPosStart,
+		DigitCount: 4,
+		SymbPos:    SymbPosStart,
+		SymbRule:   SymbRuleFixed,
+	}, `^/\d{4}[a-z]{6,8}-[a-z]{6,8}$`, t)
+}
+
+func TestDigitSeparator(t *testing.T) {
+	testPwd(&Options{
+		WordCount:  3,
+		DigitPos:   DigitPosSeparator,
+		DigitCount: 1,
+	}, `^[a-z]{6,8}\d[a-z]{6,8}\d[a-z]{6,8}$`, t)
+}
+
+func TestDigitInside(t *testing.T) {
+	testPwd(&Options{
+		WordCount:  2,
+		DigitPos:   DigitPosInside,
+		DigitCount: 1,
+	}, `^([a-z]+\d[a-z]+-[a-z]{6,8}|[a-z]{6,8}-[a-z]+\d[a-z]+)$`, t)
+}
+
+func TestDigitSpread(t *testing.T) {
+	opt := &Options{
+		WordCount:  3,
+		DigitPos:   DigitPosSpread,
+		DigitCount: 4,
+	}
+
+	for i := 0; i < 100; i++ {
+		gen := NewGenerator(opt)
+		pwd, _, err := gen.GenPassword()
+		if err != nil {
+			printError(err, t)
+		}
+
+		if !regexp.MustCompile(`^[a-z]{6,8}\d*-[a-z]{6,8}\d*-[a-z]{6,8}\d*$`).MatchString(pwd) || len(regexp.MustCompile(`\d`).FindAllString(pwd, -1)) != 4 {
+			printError(fmt.Errorf("got %q", pwd), t)
+		}
+	}
+
+	gen := NewGenerator(&Options{DigitPos: DigitPosEnd, DigitsAfter: 1})
+	if _, _, err := gen.GenPassword(); err == nil {
+		printError(errors.New("`DigitsAfter` accepted with `DigitPosEnd`"), t)
+	}
+}
+
 func TestPaddingFixed(t *testing.T) {
 	testPwd(&Options{
 		WordCount: 2,
@@ -103,6 +217,131 @@ func TestPaddingFixed(t *testing.T) {
 	}, `^[a-z]{12,16}@{4,8}$`, t)
 }
 
+func TestOptionErrors(t *testing.T) {
+	untagged, _ := NewDictionary(strings.NewReader("cat"))
+	tagged, _ := NewTaggedDictionary(strings.NewReader("noun cat"))
+
+	for _, c := range []struct {
+		opt   Options
+		field string
+		err   error
+	}{
+		{Options{Mode: "unknown"}, "Mode", ErrUnsupportedValue},
+		{Options{Mode: ModePassphrase}, "Passphrase", ErrMissingOption},
+		{Options{Mode: ModePassphrase, Passphrase: "hi there", MinEntropy: 200}, "MinEntropy", ErrConflict},
+		{Options{CapRule: "upper"}, "CapRule", ErrUnsupportedValue},
+		{Options{SymbRule: "none"}, "SymbRule", ErrUnsupportedValue},
+		{Options{SepRule: "space"}, "SepRule", ErrUnsupportedValue},
+		{Options{DigitPos: DigitPosSeparator, SepRule: SepRuleFixed}, "DigitPos", ErrConflict},
+		{Options{PadRule: "left", PadLength: 20}, "PadRule", ErrUnsupportedValue},
+		{Options{PadLength: 20}, "PadRule", ErrMissingOption},
+		{Options{Language: "xx"}, "Language", ErrUnsupportedValue},
+		{Options{MaxWordLength: 29}, "MaxWordLength", ErrOutOfRange},
+		{Options{MinWordLength: 8, MaxWordLength: 6}, "MinWordLength", ErrConflict},
+		{Options{SepRule: SepRuleRandom, SeparatorPool: "-_", Policy: &Policy{ForbiddenChars: "_-"}}, "SeparatorPool", ErrEmptyPool},
+		{Options{Policy: &Policy{MinClasses: 5}}, "Policy.MinClasses", ErrOutOfRange},
+		{Options{MaxLength: 10}, "MaxLength", ErrConflict},
+		{Options{MinEntropy: 1e6}, "MinEntropy", ErrConflict},
+		{Options{Mode: ModePattern}, "Pattern", ErrMissingOption},
+		{Options{Mode: ModePattern, Pattern: "{pronoun}"}, "Pattern", ErrUnsupportedValue},
+		{Options{Mode: ModePattern, Pattern: "{aDJ}"}, "Pattern", ErrUnsupportedValue},
+		{Options{Mode: ModePattern, Pattern: "{adj}", Dictionary: untagged}, "Dictionary", ErrConflict},
+		{Options{Mode: ModePattern, Pattern: "{noun:9}", Dictionary: tagged}, "Pattern", ErrConflict},
+		{Options{Mode: ModePattern, Pattern: "Word-{word:30}"}, "Pattern", ErrOutOfRange},
+		{Options{Mode: ModePattern, Pattern: "word", MaxLength: 5}, "MaxLength", ErrConflict},
+		{Options{Mode: ModePattern, Pattern: "99", MinEntropy: 10}, "MinEntropy", ErrConflict},
+		{Options{Mode: ModePhrase, Phrase: "noun pronoun"}, "Phrase", ErrUnsupportedValue},
+		{Options{Mode: ModePhrase, Phrase: " "}, "Phrase", ErrMissingOption},
+		{Options{Mode: ModePhrase, Dictionary: tagged}, "Phrase", ErrEmptyPool},
+		{Options{Mode: ModePhrase, Dictionary: untagged}, "Dictionary", ErrConflict},
+		{Options{Mode: ModePhrase, Language: LangFrench}, "Language", ErrUnsupportedValue},
+		{Options{Mode: ModePhrase, MaxLength: 10}, "MaxLength", ErrConflict},
+		{Options{Mode: ModePhrase, MinEntropy: 40}, "MinEntropy", ErrConflict},
+		{Options{L33tProfile: "emoji"}, "L33tProfile", ErrUnsupportedValue},
+		{Options{L33tTable: L33tTable{'A': "4"}}, "L33tTable", ErrUnsupportedValue},
+		{Options{L33tTable: L33tTable{'a': "44"}}, "L33tTable", ErrUnsupportedValue},
+		{Options{L33tTable: L33tTable{'a': "e"}}, "L33tTable", ErrUnsupportedValue},
+		{Options{L33tTable: L33tTable{'a': ""}}, "L33tTable", ErrEmptyPool},
+	} {
+		gen := NewGenerator(&c.opt)
+		_, _, err := gen.GenPassword()
+
+		var optErr *OptionError
+		if !errors.As(err, &optErr) || optErr.Field != c.field || !errors.Is(err, c.err) {
+			printError(fmt.Errorf("got %v for %s, want %v", err, c.field, c.err), t)
+		}
+	}
+
+	gen := NewGenerator(&Options{SepRule: SepRuleRandom, SeparatorPool: "-_", Policy: &Policy{ForbiddenChars: "-"}})
+	if _, _, err := gen.GenPassword(); err != nil {
+		printError(err, t)
+	}
+}
+
+func TestNew(t *testing.T) {
+	gen, err := New(WithWordCount(4), WithWordLength(5, 5), WithSeparator('.'), WithCapRule(CapRuleAll), WithDigits(DigitPosEnd, 3))
+	if err != nil {
+		printError(err, t)
+	} else if pwd, _, _ := gen.GenPassword(); !regexp.MustCompile(`^[A-Z]{5}(\.[A-Z]{5}){3}\d{3}$`).MatchString(pwd) {
+		printError(fmt.Errorf("got %q", pwd), t)
+	}
+
+	preset, _ := LoadPreset(PresetPCIDSS)
+	if gen, err = New(WithOptions(*preset), WithWordCount(2), WithWordLength(6, 6)); err != nil {
+		printError(err, t)
+	} else if pwd, _, _ := gen.GenPassword(); !regexp.MustCompile(`^[a-z]{6}-[a-z]{6}\d{2}$`).MatchString(pwd) {
+		printError(fmt.Errorf("got %q", pwd), t)
+	}
+
+	for _, c := range []struct {
+		opts []Option
+		err  error
+	}{
+		{[]Option{WithWordCount(0)}, ErrOutOfRange},
+		{[]Option{WithSeparatorPool("")}, ErrEmptyPool},
+		{[]Option{WithCapRule(CapRuleRandom), WithCapRatio(0)}, ErrOutOfRange},
+		{[]Option{WithPassphrase("")}, ErrMissingOption},
+	} {
+		if gen, err := New(c.opts...); gen != nil || !errors.Is(err, c.err) {
+			printError(fmt.Errorf("got %v, want %v", err, c.err), t)
+		}
+	}
+}
+
+func TestExplicitZero(t *testing.T) {
+	// Digits and symbols can be removed at any position
+	for _, pos := range []DigitPos{DigitPosWord, DigitPosStart, DigitPosEnd, DigitPosInside, DigitPosSpread} {
+		gen, err := New(WithDigits(pos, 0), WithSymbols(SymbPosInside, 0), WithEntropy())
+		if err != nil {
+			printError(err, t)
+			continue
+		}
+
+		if pwd, ent, err := gen.GenPassword(); err != nil || ent <= 0 || !regexp.MustCompile(`^[a-z]{6,8}(-[a-z]{6,8}){2}$`).MatchString(pwd) {
+			printError(fmt.Errorf("%s: got %q, %v", pos, pwd, err), t)
+		}
+	}
+
+	// No minimum and no maximum word length
+	gen, _ := New(WithWordLength(0, 0), WithWordCount(1), WithoutSeparator())
+	lengths := make(map[int]bool)
+	for i := 0; i < 200; i++ {
+		pwd, _, _ := gen.GenPassword()
+		lengths[len(toRunes(pwd))] = true
+	}
+
+	if lengths[6] && lengths[7] && lengths[8] && len(lengths) == 3 {
+		printError(errors.New("word lengths are still between 6 and 8"), t)
+	}
+
+	gen, err := New(WithMode(ModeNumeric), WithWordLength(0, 0), WithWordCount(1), WithEntropy())
+	if err != nil {
+		printError(err, t)
+	} else if pwd, ent, _ := gen.GenPassword(); !regexp.MustCompile(`^\d{1,28}$`).MatchString(pwd) || ent != math.Log2(28)+float64(len(pwd))*math.Log2(10) {
+		printError(fmt.Errorf("got %q with %f bits", pwd, ent), t)
+	}
+}
+
 func TestCapFirst(t *testing.T) {
 	testPwd(&Options{
 		WordCount:     2,
@@ -182,6 +421,1096 @@ func Test1337(t *testing.T) {
 	}, `^[a-zA-Z0-9]{6,8}-[a-zA-Z0-9]{6,8}$`, t)
 }
 
+func TestPattern(t *testing.T) {
+	symbol := `[@&!\-_^$*%,.;:/=+]`
+	testPwd(&Options{Mode: ModePattern, Pattern: "Word-word-99-!"}, `^[A-Z][a-z]{5,7}-[a-z]{6,8}-\d\d-`+symbol+`$`, t)
+	testPwd(&Options{Mode: ModePattern, Pattern: "CvcvC99"}, `^[B-DF-HJ-NP-TV-Z][aeiou][b-df-hj-np-tv-z][aeiou][B-DF-HJ-NP-TV-Z]\d\d$`, t)
+	testPwd(&Options{Mode: ModePattern, Pattern: "{WORD:4} {word:3-5}\\!\\9"}, `^[A-Z]{4} [a-z]{3,5}!9$`, t)
+
+	dict, _ := NewDictionary(strings.NewReader("abcd\nefgh\nijkl\nmnop\nlonger"))
+	testEntropy(&Options{Mode: ModePattern, Pattern: "{Word:4}.{word:6}", Dictionary: dict}, 2, t)
+	testEntropy(&Options{Mode: ModePattern, Pattern: "Cv99!", SymbolPool: "#+"}, math.Log2(21)+math.Log2(5)+2*math.Log2(10)+1, t)
+
+	gen, err := New(WithPattern("WORD"), WithDictionary(dict), WithWordLength(6, 6))
+	if err != nil {
+		printError(err, t)
+	} else if pwd, _, _ := gen.GenPassword(); pwd != "LONGER" {
+		printError(fmt.Errorf("got %q, want %q", pwd, "LONGER"), t)
+	}
+
+	// Parts of speech come from the tagged dictionary
+	testPwd(&Options{Mode: ModePattern, Pattern: "{Adj} {noun} {verb}"}, `^[A-Z][a-z]+ [a-z]+ [a-z]+$`, t)
+	tagged, _ := NewTaggedDictionary(strings.NewReader("adjective slow\nadjective brave\nnoun cat\nverb ran\nverb jumped"))
+	testPwd(&Options{Mode: ModePattern, Pattern: "{ADJ}-{noun}-{verb:3}", Dictionary: tagged}, `^(SLOW|BRAVE)-cat-ran$`, t)
+	testEntropy(&Options{Mode: ModePattern, Pattern: "{adjective}{noun}{verb}", Dictionary: tagged}, 2, t)
+
+	fixed := NewGenerator(&Options{Mode: ModePattern, Pattern: "pin-\\word"})
+	if pwd, report, _ := fixed.GenPasswordReport(); pwd != "pin-word" || len(report.Warnings) != 1 {
+		printError(fmt.Errorf("got %q with warnings %v, want a fixed password", pwd, report.Warnings), t)
+	}
+}
+
+func TestPhrase(t *testing.T) {
+	testPwd(&Options{Mode: ModePhrase}, `^[a-z]+-[a-z]+-[a-z]+-[a-z]+$`, t)
+	testPwd(&Options{Mode: ModePhrase, Phrase: PhraseNumberAdjNounVerb, SepRule: SepRuleNone, CapRule: CapRuleFirstLetter}, `^([2-9]|[1-9]\d)([A-Z][a-z]+){3}$`, t)
+
+	dict, _ := LoadTaggedLanguageDictionary(LangEnglish)
+	bits := 0.0
+	for _, pos := range []PartOfSpeech{PosAdjective, PosNoun, PosVerb, PosAdverb} {
+		bits += math.Log2(float64(len(dict.tags[pos])))
+	}
+
+	testEntropy(&Options{Mode: ModePhrase}, bits, t)
+	testEntropy(&Options{Mode: ModePhrase, Phrase: "number number", SepRule: SepRuleNone}, 2*math.Log2(98), t)
+
+	// Enough entropy with the digits added after each word
+	testPwd(&Options{Mode: ModePhrase, MinEntropy: 40, DigitsAfter: 1}, `^([a-z]+\d-){3}[a-z]+\d$`, t)
+
+	tagged, err := NewTaggedDictionary(strings.NewReader("adjective Rápido\nadjective slow\nnoun cat\nnoun cat\nverb ran\npronoun we\nadverb not-a-word"))
+	if err != nil {
+		printError(err, t)
+		return
+	}
+
+	if rejected := tagged.Rejected(); len(rejected) != 2 {
+		printError(fmt.Errorf("got %v, want the pronoun and the invalid word rejected", rejected), t)
+	}
+
+	testPwd(&Options{Mode: ModePhrase, Phrase: "adjective noun verb", Dictionary: tagged, StripAccents: true}, `^(rapido|slow)-cat-ran$`, t)
+	testEntropy(&Options{Mode: ModePhrase, Phrase: "adjective noun verb", Dictionary: tagged}, 1, t)
+
+	// Each word leaves room for the shortest words of the next parts of speech
+	testPwd(&Options{Mode: ModePhrase, Phrase: "adjective noun verb", Dictionary: tagged, MaxLength: 12}, `^slow-cat-ran$`, t)
+	for i := 0; i < 100; i++ {
+		gen := NewGenerator(&Options{Mode: ModePhrase, MaxLength: 22})
+		if pwd, _, err := gen.GenPassword(); err != nil || len(pwd) > 22 {
+			printError(fmt.Errorf("got %q, error %v", pwd, err), t)
+		}
+	}
+
+	limited := NewGenerator(&Options{Mode: ModePhrase, MaxLength: 22})
+	if _, report, _ := limited.GenPasswordReport(); report.Bits >= bits {
+		printError(fmt.Errorf("got %f bits, want less than %f", report.Bits, bits), t)
+	}
+
+	// The phrase mode is kept by `WithDictionary`
+	tagged, _ = NewTaggedDictionary(strings.NewReader("adjective slow\nnoun cat\nverb ran\nadverb far"))
+	gen, err := New(WithMode(ModePhrase), WithDictionary(tagged))
+	if err != nil {
+		printError(err, t)
+	} else if pwd, _, _ := gen.GenPassword(); pwd != "slow-cat-ran-far" {
+		printError(fmt.Errorf("got %q, want %q", pwd, "slow-cat-ran-far"), t)
+	}
+}
+
+func TestL33tTables(t *testing.T) {
+	dict, _ := NewDictionary(strings.NewReader("gala"))
+	opt := func(profile L33tProfile, table L33tTable) *Options {
+		return &Options{Dictionary: dict, WordCount: 1, MinWordLength: 4, MaxWordLength: 4, L33tRatio: 1, L33tProfile: profile, L33tTable: table}
+	}
+
+	testPwd(opt("", nil), `^[69]414$`, t)
+	testPwd(opt(L33tProfileSymbols, nil), `^[69][4@][1|][4@]$`, t)
+	testPwd(opt(L33tProfileSymbols, L33tTable{'a': "^"}), `^g\^l\^$`, t)
+
+	// Each letter gets one of its candidates
+	testEntropy(opt("", nil), 1, t)
+	testEntropy(opt(L33tProfileSymbols, nil), 4, t)
+	testEntropy(opt("", L33tTable{'a': "4@^"}), 2*math.Log2(3), t)
+
+	// The table is copied by the generator
+	table := L33tTable{'a': "4"}
+	gen := NewGenerator(opt("", table))
+	table['a'] = "@"
+	if pwd, _, _ := gen.GenPassword(); pwd != "g4l4" {
+		printError(fmt.Errorf("got %q, want %q", pwd, "g4l4"), t)
+	}
+
+	if got := newL33t(L33tTable{'a': "^"}).Decode("^b"); !slices.Equal(got, []string{"ab"}) {
+		printError(fmt.Errorf("got %v, want custom candidates to be decoded", got), t)
+	}
+}
+
+func TestL33tDecode(t *testing.T) {
+	l := NewL33t()
+
+	for _, c := range []struct {
+		s    string
+		want []string
+	}{
+		{"Hello", []string{"hello"}},
+		{"P4ssw0rd", []string{"password"}},
+		{"1337", []string{"ieet", "ieel", "leet", "leel"}},
+		{"h@x|", []string{"haxi", "haxl"}},
+	} {
+		if got := l.Decode(c.s); strings.Join(got, ",") != strings.Join(c.want, ",") {
+			printError(fmt.Errorf("%q: got %q, want %q", c.s, got, c.want), t)
+		}
+	}
+
+	// The number of readings is bounded
+	if got := l.Decode(strings.Repeat("17|", 10) + "1234567890!@$+"); len(got) > maxL33tReadings {
+		printError(fmt.Errorf("got %d readings", len(got)), t)
+	}
+}
+
+func TestDictionaryFind(t *testing.T) {
+	dict, _ := NewDictionary(strings.NewReader("horse\nbattery\nstaple\nleet"))
+
+	var words []string
+	for _, m := range dict.Find("c0rrectH0RSE-b4773ry!1337") {
+		words = append(words, fmt.Sprintf("%s@%d:%s:%t", m.Word, m.Start, m.Token, m.L33t))
+
+		if m.Guesses <= 0 {
+			printError(fmt.Errorf("%q has no guesses", m.Word), t)
+		}
+	}
+
+	if want := "horse@7:H0RSE:true,battery@13:b4773ry:true,leet@21:1337:true"; strings.Join(words, ",") != want {
+		printError(fmt.Errorf("got %q, want %q", words, want), t)
+	}
+}
+
+func TestPolicyCheck(t *testing.T) {
+	policy := &Policy{
+		MinLength:        12,
+		MaxLength:        16,
+		MinUpper:         1,
+		MinDigits:        2,
+		MinSymbols:       1,
+		MinClasses:       3,
+		ForbiddenChars:   "!",
+		MaxRepeat:        2,
+		BannedSubstrings: []string{"password"},
+	}
+
+	if err := policy.Check("Tiny-Horse-42"); err != nil {
+		printError(err, t)
+	}
+
+	err := policy.Check("passwordddd!")
+
+	var policyErr *PolicyError
+	if !errors.As(err, &policyErr) || len(policyErr.Violations) != 6 {
+		printError(fmt.Errorf("got %v", err), t)
+	}
+
+	for _, want := range []error{ErrMissingUpper, ErrMissingDigit, ErrMissingClasses, ErrForbiddenChar, ErrRepeatedRun, ErrBannedSubstring} {
+		if !errors.Is(err, want) {
+			printError(fmt.Errorf("%v not reported", want), t)
+		}
+	}
+
+	if errors.Is(err, ErrTooShort) || errors.Is(err, ErrTooLong) {
+		printError(fmt.Errorf("length wrongly reported: %v", err), t)
+	}
+
+	if err := policy.Check("MyP4$$w0rd-1234"); !errors.Is(err, ErrBannedSubstring) {
+		printError(fmt.Errorf("1337 coded banned substring not reported: %v", err), t)
+	}
+}
+
+func TestPolicyRegenerate(t *testing.T) {
+	policy := &Policy{MinUpper: 2, MinDigits: 1, MaxLength: 30}
+
+	for i := 0; i < 20; i++ {
+		gen := NewGenerator(&Options{CapRule: CapRuleRandom, DigitPos: DigitPosSpread, DigitCount: 1, Policy: policy})
+		pwd, _, err := gen.GenPassword()
+
+		if err != nil {
+			printError(err, t)
+		} else if err := policy.Check(pwd); err != nil {
+			printError(err, t)
+		}
+	}
+
+	gen := NewGenerator(&Options{Policy: &Policy{ForbiddenChars: "-"}})
+	if _, _, err := gen.GenPassword(); !errors.Is(err, ErrPolicyUnreachable) || !errors.Is(err, ErrForbiddenChar) {
+		printError(fmt.Errorf("got %v", err), t)
+	}
+}
+
+func TestPolicyRepair(t *testing.T) {
+	policy := &Policy{MinUpper: 1, MinDigits: 2, MinSymbols: 2, MinClasses: 4, ForbiddenChars: "@&"}
+
+	for i := 0; i < 100; i++ {
+		gen := NewGenerator(&Options{SepRule: SepRuleNone, Policy: policy, PolicyFix: PolicyFixRepair})
+		pwd, _, err := gen.GenPassword()
+
+		if err != nil {
+			printError(err, t)
+		} else if err := policy.Check(pwd); err != nil {
+			printError(err, t)
+		}
+	}
+
+	// Only the missing classes are added
+	gen := NewGenerator(&Options{WordCount: 1, SepRule: SepRuleNone, Policy: &Policy{MinClasses: 2}, PolicyFix: PolicyFixRepair})
+	if pwd, _, _ := gen.GenPassword(); !regexp.MustCompile(`^[a-z]*\d[a-z]*$`).MatchString(pwd) || len(pwd) < 7 || len(pwd) > 9 {
+		printError(fmt.Errorf("got %q", pwd), t)
+	}
+}
+
+func TestPolicyRepairMinLength(t *testing.T) {
+	policy := &Policy{MinLength: 20, ForbiddenChars: "abcdefghijklm"}
+	gen := NewGenerator(&Options{WordCount: 1, MinWordLength: 4, MaxWordLength: 4, Policy: policy, PolicyFix: PolicyFixRepair})
+
+	if pwd, _, err := gen.GenPassword(); err != nil || len(pwd) != 20 {
+		printError(fmt.Errorf("got %q, error %v, want 20 characters", pwd, err), t)
+	}
+}
+
+func TestPolicyRepairLarge(t *testing.T) {
+	policy := &Policy{MinUpper: 200, MinDigits: 2000, MinSymbols: 2000}
+	gen := NewGenerator(&Options{Policy: policy, PolicyFix: PolicyFixRepair})
+
+	if pwd, _, err := gen.GenPassword(); err != nil {
+		printError(err, t)
+	} else if err := policy.Check(pwd); err != nil {
+		printError(err, t)
+	}
+}
+
+func TestPolicyConflicts(t *testing.T) {
+	for _, c := range []struct {
+		opt   Options
+		field string
+	}{
+		{Options{Policy: &Policy{MinLength: 20, MaxLength: 10}}, "Policy.MinLength"},
+		{Options{MaxLength: 30, Policy: &Policy{MinLength: 60}}, "Policy.MinLength"},
+		{Options{Policy: &Policy{MaxLength: 10, MinDigits: 6, MinSymbols: 6}}, "Policy.MaxLength"},
+		{Options{MaxLength: 20, Policy: &Policy{MinLower: 10, MinUpper: 11}}, "MaxLength"},
+	} {
+		var optErr *OptionError
+		gen := NewGenerator(&c.opt)
+		if _, _, err := gen.GenPassword(); !errors.As(err, &optErr) || optErr.Field != c.field || !errors.Is(err, ErrConflict) {
+			printError(fmt.Errorf("%s: got %v", c.field, err), t)
+		}
+	}
+}
+
+func TestMaxLength(t *testing.T) {
+	for _, opt := range []*Options{
+		{MaxLength: 20},
+		{MaxLength: 20, Mode: ModeRand, MinWordLength: 3, MaxWordLength: 10},
+		{MaxLength: 24, DigitPos: DigitPosEnd, SymbPos: SymbPosEnd, CapRule: CapRuleFirstLetter},
+		{MaxLength: 24, MinEntropy: 40},
+	} {
+		for i := 0; i < 100; i++ {
+			gen := NewGenerator(opt)
+			pwd, _, err := gen.GenPassword()
+
+			if err != nil {
+				printError(err, t)
+			} else if len(pwd) > int(opt.MaxLength) {
+				printError(fmt.Errorf("%q is longer than %d", pwd, opt.MaxLength), t)
+			}
+		}
+	}
+
+	for _, opt := range []*Options{
+		{MaxLength: 18},
+		{MaxLength: 12, Mode: ModePassphrase, Passphrase: "a passphrase that is too long"},
+		{MaxLength: 16, MinEntropy: 80},
+	} {
+		gen := NewGenerator(opt)
+		if _, _, err := gen.GenPassword(); err == nil {
+			printError(fmt.Errorf("%d characters accepted", opt.MaxLength), t)
+		}
+	}
+}
+
+func TestEntropyMaxLength(t *testing.T) {
+	dict, _ := NewDictionary(strings.NewReader("ab\nabc\nabcd"))
+	opt := &Options{Dictionary: dict, WordCount: 2, MinWordLength: 2, MaxWordLength: 4, SepRule: SepRuleNone, MaxLength: 6}
+
+	// The first word is any of the 3 words, the second one is any of the words that fit in the remaining letters
+	fitting := map[int]float64{2: 3, 3: 2, 4: 1}
+
+	for i := 0; i < 50; i++ {
+		gen := NewGenerator(opt)
+		pwd, report, err := gen.GenPasswordReport()
+
+		if err != nil {
+			printError(err, t)
+			continue
+		}
+
+		first := strings.Index(pwd[1:], "ab") + 1
+		if bits := math.Log2(3) + math.Log2(fitting[first]); math.Abs(report.Bits-bits) > 1e-9 {
+			printError(fmt.Errorf("got %f bits for %q, want %f", report.Bits, pwd, bits), t)
+		}
+	}
+}
+
+func TestPresets(t *testing.T) {
+	names := []string{PresetADComplexity, PresetAWSIAM, PresetNIST, PresetPCIDSS, PresetPIN, PresetWPA2}
+	list, err := Presets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list) != len(names) {
+		printError(fmt.Errorf("got %d presets, want %d", len(list), len(names)), t)
+	}
+
+	for i, p := range list {
+		if p.Name != names[i] || p.Description == "" {
+			printError(fmt.Errorf("got preset %q at position %d", p.Name, i), t)
+		}
+
+		for j := 0; j < 20; j++ {
+			opt, err := LoadPreset(p.Name)
+			if err != nil {
+				printError(err, t)
+				break
+			}
+
+			gen := NewGenerator(opt)
+			pwd, _, err := gen.GenPassword()
+
+			if err != nil {
+				printError(err, t)
+			} else if err := opt.Policy.Check(pwd); err != nil {
+				printError(fmt.Errorf("%s: %v", p.Name, err), t)
+			}
+		}
+	}
+
+	testPwd(&list[4].Options, `^\d{8}$`, t)
+	testPwd(&list[1].Options, `^([A-Z][a-z]+-){2}[A-Z][a-z]+\d\d[!@#$%^&*()_+\-=\[\]{}|']$`, t)
+
+	// Presets are copied
+	opt, _ := LoadPreset(PresetNIST)
+	opt.Policy.MinLength = 100
+	if opt, _ = LoadPreset(PresetNIST); opt.Policy.MinLength != 15 {
+		printError(errors.New("preset changed"), t)
+	}
+
+	if _, err := LoadPreset("unknown"); err == nil {
+		printError(errors.New("unknown preset loaded"), t)
+	}
+}
+
+func TestBuiltinPresets(t *testing.T) {
+	for name := range presets {
+		if _, err := LoadPreset(name); err != nil {
+			printError(err, t)
+		}
+	}
+}
+
+func TestInvalidPreset(t *testing.T) {
+	presets["broken"] = Preset{Options: Options{Mode: "unknown"}}
+	t.Cleanup(func() { delete(presets, "broken") })
+
+	if list, err := Presets(); err == nil || !strings.Contains(err.Error(), "broken") {
+		printError(fmt.Errorf("got %d presets, error %v, want the invalid preset reported", len(list), err), t)
+	}
+}
+
+func TestGenPasswords(t *testing.T) {
+	// 1000 possible passwords
+	gen := NewGenerator(&Options{Mode: ModeNumeric, WordCount: 1, MinWordLength: 3, MaxWordLength: 3})
+	pwds, err := gen.GenPasswords(500)
+
+	if err != nil {
+		printError(err, t)
+	}
+
+	seen := make(map[string]bool)
+	for _, pwd := range pwds {
+		if seen[pwd] || !regexp.MustCompile(`^\d{3}$`).MatchString(pwd) {
+			printError(fmt.Errorf("got %q twice or invalid", pwd), t)
+		}
+
+		seen[pwd] = true
+	}
+
+	if len(seen) != 500 {
+		printError(fmt.Errorf("got %d passwords", len(seen)), t)
+	}
+
+	gen = NewGenerator(&Options{Mode: ModeNumeric, WordCount: 1, MinWordLength: 1, MaxWordLength: 1})
+	if _, err := gen.GenPasswords(11); err == nil {
+		printError(errors.New("11 distinct digits generated"), t)
+	}
+
+	if _, err := gen.GenPasswords(math.MaxInt); err == nil {
+		printError(errors.New("MaxInt distinct digits generated"), t)
+	}
+
+	gen = NewGenerator(&Options{Mode: ModeNumeric, WordCount: 1, MinWordLength: 4, MaxWordLength: 4, MinEditDistance: 3})
+	pwds, reports, err := gen.GenPasswordsReport(20)
+
+	if err != nil || len(reports) != 20 || reports[0].Bits != 4*math.Log2(10) {
+		printError(fmt.Errorf("got %v, %v", reports, err), t)
+	}
+
+	for i := range pwds {
+		for j := i + 1; j < len(pwds); j++ {
+			if d := editDistance(toRunes(pwds[i]), toRunes(pwds[j]), 10); d < 3 {
+				printError(fmt.Errorf("%q and %q are %d edits away", pwds[i], pwds[j], d), t)
+			}
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	for _, c := range []struct {
+		a, b string
+		max  int
+		want int
+	}{
+		{"kitten", "sitting", 10, 3},
+		{"kitten", "sitting", 2, 2},
+		{"", "abc", 10, 3},
+		{"abc", "abc", 10, 0},
+		{"flaw", "lawn", 10, 2},
+		{"été", "ete", 10, 2},
+		{"abcdef", "ab", 3, 3},
+	} {
+		if got := editDistance(toRunes(c.a), toRunes(c.b), c.max); got != c.want {
+			printError(fmt.Errorf("distance between %q and %q is %d, want %d", c.a, c.b, got, c.want), t)
+		}
+	}
+}
+
+func TestSeededDict(t *testing.T) {
+	testGolden(&Options{
+		Random: NewSeededRandom(42),
+	}, "eyebrows-tinging-burses", t)
+}
+
+func TestSeededRand(t *testing.T) {
+	testGolden(&Options{
+		Random:      NewSeededRandom(42),
+		Mode:        ModeRand,
+		WordCount:   2,
+		DigitsAfter: 2,
+		CapRule:     CapRuleFirstLetter,
+	}, "Fleverse23-Lliandan21", t)
+}
+
+func TestRandWordLength(t *testing.T) {
+	// Some words reach a pair of letters no trigram follows before their length
+	for i := 0; i < 200; i++ {
+		testPwd(&Options{Mode: ModeRand, WordCount: 1, MinWordLength: 28, MaxWordLength: 28}, `^[a-z]{28}$`, t)
+	}
+
+	testPwd(&Options{Mode: ModeRand, WordCount: 4, MinWordLength: 1, MaxWordLength: 3}, `^[a-z]{3}(-[a-z]{3}){3}$`, t)
+}
+
+func TestSeededPassphrase(t *testing.T) {
+	testGolden(&Options{
+		Random:     NewSeededRandom(7),
+		Mode:       ModePassphrase,
+		Passphrase: "I like strong passwords",
+	}, "I-like-5tron9-PasSwords", t)
+}
+
+func TestSeededMixed(t *testing.T) {
+	testGolden(&Options{
+		Random:       NewSeededRandom(7),
+		SepRule:      SepRuleRandom,
+		SymbolsAfter: 1,
+		L33tRatio:    .5,
+		CapRule:      CapRuleRandom,
+	}, "150m3R:^my5tic*^jonqu11s%", t)
+}
+
+func TestDictUniform(t *testing.T) {
+	// There are 140 words of 2 letters and 853 words of 3 letters in the dictionary
+	words, err := getDictWords(&Options{
+		WordCount:     1000,
+		MinWordLength: 2,
+		MaxWordLength: 3,
+		Language:      LangEnglish,
+	}, NewSeededRandom(1), 0)
+
+	if err != nil {
+		printError(err, t)
+		return
+	}
+
+	short := 0
+	for _, w := range words {
+		if len(w) == 2 {
+			short++
+		}
+	}
+
+	// Expect about 141 short words, a per-length pick would give about 500
+	if short < 100 || short > 200 {
+		printError(fmt.Errorf("got %d words of 2 letters out of 1000", short), t)
+	}
+}
+
+func TestWordIndex(t *testing.T) {
+	index := newWordIndex([]string{"ccc", "a", "bb", "dddd", "eee", "ff"})
+
+	tests := []struct {
+		min, max uint
+		want     string
+	}{
+		{0, 0, "a bb ff ccc eee dddd"},
+		{2, 3, "bb ff ccc eee"},
+		{3, 3, "ccc eee"},
+		{4, 10, "dddd"},
+		{5, 0, ""},
+		{3, 2, ""},
+	}
+
+	for _, test := range tests {
+		got := strings.Join(index.lookup(test.min, test.max), " ")
+		if got != test.want {
+			printError(fmt.Errorf("lookup(%d, %d): got %q, want %q", test.min, test.max, got, test.want), t)
+		}
+	}
+}
+
+func TestCustomDictionary(t *testing.T) {
+	dict, err := NewDictionary(strings.NewReader("# Comment\n\nAlpha\nalpha\n11111\tbravo\n  charlie  \nt-shirt\nd3lta\n"))
+	if err != nil {
+		printError(err, t)
+		return
+	}
+
+	if dict.Len() != 3 {
+		printError(fmt.Errorf("got %d words, want 3", dict.Len()), t)
+	}
+
+	if got := strings.Join(dict.Rejected(), " "); got != "t-shirt d3lta" {
+		printError(fmt.Errorf("got rejected %q", got), t)
+	}
+
+	testPwd(&Options{
+		Dictionary:    dict,
+		MinWordLength: 5,
+		MaxWordLength: 7,
+	}, `^(alpha|bravo|charlie)-(alpha|bravo|charlie)-(alpha|bravo|charlie)$`, t)
+}
+
+func TestCustomDictionaryFS(t *testing.T) {
+	fsys := fstest.MapFS{"words.txt": {Data: []byte("alpha\nbravo\n")}}
+
+	dict, err := LoadDictionaryFS(fsys, "words.txt")
+	if err != nil {
+		printError(err, t)
+	} else if dict.Len() != 2 {
+		printError(fmt.Errorf("got %d words, want 2", dict.Len()), t)
+	}
+
+	if _, err := LoadDictionaryFS(fsys, "missing.txt"); err == nil {
+		printError(errors.New("missing file should fail"), t)
+	}
+
+	if _, err := NewDictionary(strings.NewReader("# Only a comment\n")); err == nil {
+		printError(errors.New("empty dictionary should fail"), t)
+	}
+}
+
+func TestCustomDictionaryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(path, []byte("alpha\r\nbravo\r\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	dict, err := LoadDictionaryFile(path)
+	if err != nil {
+		printError(err, t)
+	} else if dict.Len() != 2 {
+		printError(fmt.Errorf("got %d words, want 2", dict.Len()), t)
+	}
+
+	// Default word length is 6 to 8, no word matches
+	gen := NewGenerator(&Options{Dictionary: dict})
+	if _, _, err := gen.GenPassword(); err == nil {
+		printError(errors.New("no matching word should fail"), t)
+	}
+}
+
+func TestLanguages(t *testing.T) {
+	for lang := range embeddedDicts {
+		testPwd(&Options{
+			Language:      lang,
+			MinWordLength: 4,
+		}, `^\pL{4,8}-\pL{4,8}-\pL{4,8}$`, t)
+
+		testPwd(&Options{
+			Language:      lang,
+			MinWordLength: 4,
+			StripAccents:  true,
+		}, `^[a-z]{4,8}-[a-z]{4,8}-[a-z]{4,8}$`, t)
+	}
+
+	gen := NewGenerator(&Options{Language: "xx"})
+	if _, _, err := gen.GenPassword(); err == nil {
+		printError(errors.New("unknown language should fail"), t)
+	}
+}
+
+func TestDictionaryNormalization(t *testing.T) {
+	// "ÉCOLE" with a decomposed accent is the same word as "école" with a composed one
+	dict, err := NewDictionary(strings.NewReader("E\u0301COLE\nStraße\nécole\nÆther\n"))
+	if err != nil {
+		printError(err, t)
+		return
+	}
+
+	if got := strings.Join(dict.index.words, " "); got != "école æther straße" {
+		printError(fmt.Errorf("got %q", got), t)
+	}
+
+	if got := strings.Join(dict.WithoutAccents().index.words, " "); got != "ecole aether strasse" {
+		printError(fmt.Errorf("got %q without accents", got), t)
+	}
+}
+
+func TestEntropyDict(t *testing.T) {
+	pool, err := getDictPool(&Options{MinWordLength: 6, MaxWordLength: 8, Language: LangEnglish})
+	if err != nil {
+		printError(err, t)
+		return
+	}
+
+	words := 3 * math.Log2(float64(len(pool)))
+
+	testEntropy(&Options{}, words, t)
+	testEntropy(&Options{SepRule: SepRuleRandom, SeparatorPool: "!?"}, words+1, t)
+	testEntropy(&Options{DigitsAfter: 2}, words+6*math.Log2(10), t)
+	testEntropy(&Options{SymbolsBefore: 1, SymbRule: SymbRuleFixed}, words, t)
+	testEntropy(&Options{SymbolsBefore: 1, SymbolPool: "!?!?"}, words+3, t)
+	testEntropy(&Options{CapRule: CapRuleFirstLetter}, words, t)
+	testEntropy(&Options{PadLength: 40, PadRule: PadRuleFixed}, words, t)
+}
+
+func TestEntropyRand(t *testing.T) {
+	short := NewGenerator(&Options{Mode: ModeRand, MinWordLength: 4, MaxWordLength: 4, CalculateEntropy: true})
+	long := NewGenerator(&Options{Mode: ModeRand, MinWordLength: 8, MaxWordLength: 8, CalculateEntropy: true})
+
+	_, shortEnt, _ := short.GenPassword()
+	_, longEnt, _ := long.GenPassword()
+
+	// Each letter of a randomly generated word carries less than log2(26) bits
+	if shortEnt <= 0 || longEnt <= shortEnt || longEnt > 3*8*math.Log2(26) {
+		printError(fmt.Errorf("got %f bits for 4 letters words and %f bits for 8 letters words", shortEnt, longEnt), t)
+	}
+}
+
+func TestEntropySymbols(t *testing.T) {
+	pool := math.Log2(16)
+	words := math.Log2(2048)
+	dict, _ := LoadLanguageDictionary(LangFrench)
+
+	testEntropy(&Options{Dictionary: dict, MinWordLength: 1, MaxWordLength: 28, SymbPos: SymbPosStart, SymbolCount: 2}, 3*words+2*pool, t)
+	testEntropy(&Options{Dictionary: dict, MinWordLength: 1, MaxWordLength: 28, SymbPos: SymbPosBetween}, 3*words+2*pool, t)
+	testEntropy(&Options{Dictionary: dict, MinWordLength: 1, MaxWordLength: 28, SymbPos: SymbPosEnd, SymbRule: SymbRuleFixed}, 3*words, t)
+
+	// 2 words of 6 letters have 10 inner positions
+	opt := &Options{WordCount: 2, MinWordLength: 6, MaxWordLength: 6, SymbPos: SymbPosInside, SymbolCount: 2, Language: LangEnglish}
+	pool6, _ := getDictPool(opt)
+	testEntropy(opt, 2*math.Log2(float64(len(pool6)))+2*pool+math.Log2(55), t)
+}
+
+func TestEntropyDigits(t *testing.T) {
+	digit := math.Log2(10)
+	words := math.Log2(2048)
+	dict, _ := LoadLanguageDictionary(LangFrench)
+
+	testEntropy(&Options{Dictionary: dict, MinWordLength: 1, MaxWordLength: 28, DigitPos: DigitPosEnd, DigitCount: 3}, 3*words+3*digit, t)
+	testEntropy(&Options{Dictionary: dict, MinWordLength: 1, MaxWordLength: 28, DigitPos: DigitPosSeparator}, 3*words+4*digit, t)
+
+	// 4 digits spread over 3 words: 15 ways
+	testEntropy(&Options{Dictionary: dict, MinWordLength: 1, MaxWordLength: 28, DigitPos: DigitPosSpread, DigitCount: 4}, 3*words+4*digit+math.Log2(15), t)
+
+	// 2 words of 6 letters have 10 inner positions, 11 once a symbol is inserted
+	opt := &Options{WordCount: 2, MinWordLength: 6, MaxWordLength: 6, SymbPos: SymbPosInside, SymbRule: SymbRuleFixed, DigitPos: DigitPosInside, Language: LangEnglish}
+	pool, _ := getDictPool(opt)
+	testEntropy(opt, 2*math.Log2(float64(len(pool)))+math.Log2(10)+2*digit+math.Log2(66), t)
+}
+
+func TestEntropyNumeric(t *testing.T) {
+	testEntropy(&Options{Mode: ModeNumeric, WordCount: 2, MinWordLength: 4, MaxWordLength: 4}, 8*math.Log2(10), t)
+	testEntropy(&Options{Mode: ModeNumeric, WordCount: 1, MinWordLength: 5, MaxWordLength: 5, CapRule: CapRuleRandom}, 5*math.Log2(10), t)
+}
+
+func TestEntropyPassphrase(t *testing.T) {
+	gen := NewGenerator(&Options{Mode: ModePassphrase, Passphrase: "I like strong passwords", CalculateEntropy: true})
+	if _, ent, err := gen.GenPassword(); err != nil || ent <= 0 {
+		printError(fmt.Errorf("got %f bits, error %v", ent, err), t)
+	}
+
+	gen = NewGenerator(&Options{Mode: ModePassphrase, Passphrase: "I like strong passwords", MinEntropy: 5, CalculateEntropy: true})
+	if _, ent, err := gen.GenPassword(); err != nil || ent < 5 {
+		printError(fmt.Errorf("got %f bits, error %v", ent, err), t)
+	}
+
+	// The lowest entropy of the changes is checked once, whatever the random choices
+	for seed := int64(0); seed < 20; seed++ {
+		gen = NewGenerator(&Options{Mode: ModePassphrase, Passphrase: "hi there", MinEntropy: 4.39, Random: NewSeededRandom(seed)})
+		for i := 0; i < 10; i++ {
+			if _, _, err := gen.GenPassword(); err != nil {
+				printError(err, t)
+			}
+		}
+
+		gen = NewGenerator(&Options{Mode: ModePassphrase, Passphrase: "hi there", MinEntropy: 4.4, Random: NewSeededRandom(seed)})
+		if _, _, err := gen.GenPassword(); !errors.Is(err, ErrConflict) {
+			printError(fmt.Errorf("got %v, want a conflict", err), t)
+		}
+	}
+
+	// Asks for more uppercase letters than there are lowercase letters to change
+	testPwd(&Options{Mode: ModePassphrase, Passphrase: "ABCDEFGHIJKLMNOPQr"}, `^ABCDEFGHIJKLMNOPQR[A-Z]\d+-+$`, t)
+}
+
+func TestEntropyReport(t *testing.T) {
+	gen := NewGenerator(&Options{
+		DigitsBefore:  1,
+		DigitsAfter:   2,
+		SymbolsAfter:  1,
+		SymbRule:      SymbRuleFixed,
+		CapRule:       CapRuleRandom,
+		L33tRatio:     .5,
+		MinWordLength: 6,
+		MaxWordLength: 6,
+	})
+
+	_, report, err := gen.GenPasswordReport()
+	if err != nil {
+		printError(err, t)
+		return
+	}
+
+	want := map[EntropySource]float64{
+		EntropyDigitsBefore: 3 * math.Log2(10),
+		EntropyDigitsAfter:  6 * math.Log2(10),
+		EntropySymbolsAfter: 0,
+	}
+
+	total := 0.0
+	for _, c := range report.Components {
+		total += c.Bits
+		if bits, exists := want[c.Source]; exists && math.Abs(bits-c.Bits) > 1e-9 {
+			printError(fmt.Errorf("got %f bits for %s, want %f", c.Bits, c.Source, bits), t)
+		}
+	}
+
+	if math.Abs(total-report.Bits) > 1e-9 {
+		printError(fmt.Errorf("components sum to %f bits, total is %f", total, report.Bits), t)
+	}
+
+	// Every letter is randomly capitalized, at most 18 bits
+	if bits := report.Get(EntropyCapitalization); bits <= 0 || bits > 18 {
+		printError(fmt.Errorf("got %f bits for capitalization", bits), t)
+	}
+
+	// Fixed separator and fixed symbol
+	if len(report.Warnings) != 2 {
+		printError(fmt.Errorf("got warnings %q", report.Warnings), t)
+	}
+
+	gen = NewGenerator(&Options{Mode: ModePassphrase, Passphrase: "correct horse battery staple"})
+	if _, report, _ = gen.GenPasswordReport(); report.Get(EntropyPassphrase) != report.Bits || len(report.Warnings) != 1 {
+		printError(fmt.Errorf("got passphrase report %+v", report), t)
+	}
+}
+
+func TestMinEntropy(t *testing.T) {
+	for _, opt := range []*Options{
+		{MinEntropy: 60},
+		{MinEntropy: 80, SymbRule: SymbRuleRandom},
+		{MinEntropy: 70, Mode: ModeRand},
+		{MinEntropy: 50, CapRule: CapRuleRandom, WordCount: 10},
+	} {
+		for i := 0; i < 20; i++ {
+			gen := NewGenerator(opt)
+			if _, report, err := gen.GenPasswordReport(); err != nil {
+				printError(err, t)
+			} else if report.Bits < opt.MinEntropy {
+				printError(fmt.Errorf("got %f bits with %d words, want at least %f", report.Bits, opt.WordCount, opt.MinEntropy), t)
+			}
+		}
+	}
+
+	// Words are only added while digits cannot close the gap
+	pool, _ := getDictPool(&Options{MinWordLength: 6, MaxWordLength: 8, Language: LangEnglish})
+	gen := NewGenerator(&Options{MinEntropy: 48, Language: LangEnglish})
+	opt := gen.opt
+	if words := math.Log2(float64(len(pool))); opt.WordCount != 3 || float64(opt.WordCount)*(words+float64(opt.DigitsAfter)*math.Log2(10)) < 48 {
+		printError(fmt.Errorf("got %d words and %d digits", opt.WordCount, opt.DigitsAfter), t)
+	}
+
+	// Digits and symbols set by the caller are kept, even to 0
+	for _, c := range []struct {
+		gen     func() (*Generator, error)
+		pattern string
+	}{
+		{func() (*Generator, error) { return New(WithMinEntropy(48), WithDigits(DigitPosWord, 0)) }, `^[a-z]+(-[a-z]+)+$`},
+		{func() (*Generator, error) { return New(WithMinEntropy(48), WithSymbols(SymbPosWord, 0)) }, `^[a-z]+\d{0,2}(-[a-z]+\d{0,2})+$`},
+		{func() (*Generator, error) {
+			g := NewGenerator(&Options{MinEntropy: 48, DigitsAfter: 1})
+			return &g, nil
+		}, `^[a-z]+\d(-[a-z]+\d)+$`},
+	} {
+		gen, err := c.gen()
+		if err != nil {
+			printError(err, t)
+			continue
+		}
+
+		if pwd, _, err := gen.GenPassword(); err != nil || !regexp.MustCompile(c.pattern).MatchString(pwd) {
+			printError(fmt.Errorf("got %q, error %v, want it to match %s", pwd, err, c.pattern), t)
+		}
+	}
+
+	gen = NewGenerator(&Options{MinEntropy: 1e6})
+	if _, _, err := gen.GenPassword(); err == nil {
+		printError(errors.New("unreachable entropy accepted"), t)
+	}
+}
+
+func TestGeneratorReuse(t *testing.T) {
+	// The padding size depends on the length of the previous passwords if the state is not reset
+	gen := NewGenerator(&Options{PadLength: 40, PadRule: PadRuleRandom, CalculateEntropy: true})
+	_, first, _ := gen.GenPassword()
+
+	for i := 0; i < 50; i++ {
+		if pwd, ent, err := gen.GenPassword(); err != nil {
+			printError(err, t)
+		} else if len(pwd) != 40 || ent < first-50 {
+			printError(fmt.Errorf("got %q with %f bits at call %d", pwd, ent, i), t)
+		}
+	}
+
+	// The options are copied, the caller's struct is left untouched
+	opt := &Options{}
+	gen = NewGenerator(opt)
+	opt.WordCount = 5
+
+	if pwd, _, _ := gen.GenPassword(); opt.MinWordLength != 0 || strings.Count(pwd, "-") != 2 {
+		printError(fmt.Errorf("got %q, options %+v", pwd, opt), t)
+	}
+
+	gen = NewGenerator(&Options{MinEntropy: -1})
+	for i := 0; i < 2; i++ {
+		if _, _, err := gen.GenPassword(); err == nil {
+			printError(errors.New("invalid options accepted"), t)
+		}
+	}
+}
+
+func TestGeneratorConcurrency(t *testing.T) {
+	gen := NewGenerator(&Options{
+		MinEntropy: 60,
+		SymbRule:   SymbRuleRandom,
+		SymbPos:    SymbPosInside,
+		DigitPos:   DigitPosSpread,
+		PadLength:  40,
+		PadRule:    PadRuleRandom,
+		Policy:     &Policy{MinUpper: 1},
+		PolicyFix:  PolicyFixRepair,
+	})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 50; j++ {
+				pwd, report, err := gen.GenPasswordReport()
+				if err == nil && (report.Bits <= 0 || len(toRunes(pwd)) < 40) {
+					err = fmt.Errorf("got %q with %f bits", pwd, report.Bits)
+				}
+
+				if err == nil {
+					_, err = gen.GenPasswords(5)
+				}
+
+				if err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		printError(err, t)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	for _, c := range []struct {
+		password string
+		pattern  MatchPattern
+		maxScore int
+	}{
+		{"password", MatchDictionary, 0},
+		{"P4ssw0rd", MatchDictionary, 0},
+		{"qwErty", MatchDictionary, 0},
+		{"zxcvfr", MatchKeyboard, 1},
+		{"aaaaaaaa", MatchRepeat, 0},
+		{"abcabcabc", MatchRepeat, 0},
+		{"98765", MatchSequence, 0},
+		{"1987", MatchDate, 0},
+		{"13/05/1987", MatchDate, 1},
+		{"19870513", MatchDate, 1},
+	} {
+		s := testAnalyze(c.password, t)
+		if s.Score > c.maxScore || len(s.Matches) != 1 || s.Matches[0].Pattern != c.pattern || s.Feedback.Warning == "" {
+			printError(fmt.Errorf("%q: got score %d, matches %+v, feedback %+v", c.password, s.Score, s.Matches, s.Feedback), t)
+		}
+	}
+
+	s := testAnalyze("P4ssw0rd", t)
+	if m := s.Matches[0]; !m.L33t || m.Word != "password" || m.Guesses <= testAnalyze("password", t).Guesses {
+		printError(fmt.Errorf("got %+v", m), t)
+	}
+
+	s = testAnalyze("correcthorsebatterystaple", t)
+	if s.Score != 4 || len(s.Matches) != 4 || s.Matches[1].Word != "horse" || s.Feedback.Warning != "" {
+		printError(fmt.Errorf("got score %d, matches %+v", s.Score, s.Matches), t)
+	}
+
+	if s.CrackTimes.OnlineThrottled <= s.CrackTimes.OnlineUnthrottled || s.CrackTimes.OfflineSlowHash <= s.CrackTimes.OfflineFastHash {
+		printError(fmt.Errorf("got crack times %+v", s.CrackTimes), t)
+	}
+
+	// The matches cover the whole password
+	for _, password := range []string{"", "Summer2024!", "kX9#mQ2$vL7!", strings.Repeat("xk", 100)} {
+		s = testAnalyze(password, t)
+		covered := ""
+		for _, m := range s.Matches {
+			covered += m.Token
+		}
+
+		if covered != password || s.Guesses < 1 {
+			printError(fmt.Errorf("%q: got matches %+v", password, s.Matches), t)
+		}
+	}
+
+	// Guesses stay finite for long passwords
+	for _, password := range []string{strings.Repeat("x1", 260), strings.Repeat("kX9#", 1000)} {
+		s = testAnalyze(password, t)
+		if math.IsInf(s.Guesses, 0) || math.IsInf(s.GuessesLog10, 0) || math.IsInf(s.CrackTimes.OfflineFastHash, 0) || s.Score != 4 {
+			printError(fmt.Errorf("got guesses %v, score %d", s.Guesses, s.Score), t)
+		}
+
+		for _, m := range s.Matches {
+			if math.IsInf(m.Guesses, 0) {
+				printError(fmt.Errorf("got match %+v", m), t)
+			}
+		}
+	}
+
+	// Generated passwords are strong
+	gen := NewGenerator(nil)
+	pwd, _, _ := gen.GenPassword()
+	if s = testAnalyze(pwd, t); s.Score < 3 {
+		printError(fmt.Errorf("%q: got score %d", pwd, s.Score), t)
+	}
+
+	// Only words with every letter uppercase are reported as all uppercase
+	for password, allUpper := range map[string]bool{"PASSWORD": true, "P4SSW0RD": true, "Password": false, "passworD": false} {
+		s = testAnalyze(password, t)
+		if slices.Contains(s.Feedback.Suggestions, "All-uppercase is almost as easy to guess as all-lowercase") != allUpper {
+			printError(fmt.Errorf("%q: got suggestions %q", password, s.Feedback.Suggestions), t)
+		}
+	}
+}
+
+// Analyze a password with the embedded English dictionary
+func testAnalyze(password string, t *testing.T) *Strength {
+	s, err := Analyze(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func TestRandInt(t *testing.T) {
+	seen := make([]bool, 7)
+
+	for i := 0; i < 1000; i++ {
+		n := randInt(len(seen))
+		if n < 0 || n >= len(seen) {
+			printError(errors.New("randInt out of range"), t)
+			return
+		}
+		seen[n] = true
+	}
+
+	for _, s := range seen {
+		if !s {
+			printError(errors.New("randInt never returned some values"), t)
+		}
+	}
+
+	for i := 0; i < 1000; i++ {
+		if f := randFloat32(); f < 0 || f >= 1 {
+			printError(errors.New("randFloat32 out of range"), t)
+			return
+		}
+	}
+}
+
+func BenchmarkGenPassword(b *testing.B) {
+	gen := NewGenerator(&Options{})
+
+	for i := 0; i < b.N; i++ {
+		if _, _, err := gen.GenPassword(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGenPasswords(b *testing.B) {
+	gen := NewGenerator(&Options{MinEditDistance: 3})
+
+	for i := 0; i < b.N; i++ {
+		if _, err := gen.GenPasswords(1000); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAnalyze(b *testing.B) {
+	Analyze("")
+
+	for i := 0; i < b.N; i++ {
+		Analyze("Tr0ub4dor&3-correcthorse-1987")
+	}
+}
+
+func BenchmarkGetDictWords(b *testing.B) {
+	opt := &Options{WordCount: 3, MinWordLength: 6, MaxWordLength: 8, Language: LangEnglish}
+	rnd := NewSecureRandom()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := getDictWords(opt, rnd, 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLoadDictionary(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := LoadDictionaryFS(embeddedFile, "wordsEn.txt"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func testPwd(opt *Options, pattern string, t *testing.T) {
 	gen := NewGenerator(opt)
 	pwd, ent, err := gen.GenPassword()
@@ -200,6 +1529,33 @@ func testPwd(opt *Options, pattern string, t *testing.T) {
 	}
 }
 
+func testGolden(opt *Options, want string, t *testing.T) {
+	gen := NewGenerator(opt)
+	pwd, _, err := gen.GenPassword()
+
+	if err != nil {
+		printError(err, t)
+	}
+
+	if pwd != want {
+		printError(fmt.Errorf("got %q, want %q", pwd, want), t)
+	}
+}
+
+func testEntropy(opt *Options, want float64, t *testing.T) {
+	opt.CalculateEntropy = true
+	gen := NewGenerator(opt)
+	_, ent, err := gen.GenPassword()
+
+	if err != nil {
+		printError(err, t)
+	}
+
+	if math.Abs(ent-want) > 1e-9 {
+		printError(fmt.Errorf("got %f bits, want %f", ent, want), t)
+	}
+}
+
 func printError(err error, t *testing.T) {
 	t.Errorf("Test failed: %v\n", err)
 }
@@ -0,0 +1,284 @@
+package mempass
+
+// Fields of `Options` that get a default value when they are zero
+type optionFields uint
+
+const (
+	fieldWordCount optionFields = 1 << iota
+	fieldMinWordLength
+	fieldMaxWordLength
+	fieldDigitCount
+	fieldSymbolCount
+	fieldCapRatio
+	fieldSeparatorPool
+	fieldSymbolPool
+)
+
+// Check if a field was not set by a functional option, so it gets its default value if it is zero
+func (o *Options) unset(f optionFields) bool {
+	return o.explicit&f == 0
+}
+
+// An option of `New`. Unlike the fields of `Options`, a value set by an option is kept even if it is zero
+type Option func(*Options)
+
+// Create a generator from functional options. Options that are not set get their default value.
+// The options are validated immediately, an `*OptionError` is returned if they are not valid
+func New(opts ...Option) (*Generator, error) {
+	opt := Options{}
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	g := NewGenerator(&opt)
+	if g.err != nil {
+		return nil, g.err
+	}
+
+	return &g, nil
+}
+
+// Start from a set of options, such as a preset. It replaces the options set before, so it must come first
+func WithOptions(opt Options) Option {
+	return func(o *Options) {
+		*o = opt
+	}
+}
+
+// Generation mode
+func WithMode(mode Mode) Option {
+	return func(o *Options) {
+		o.Mode = mode
+	}
+}
+
+// Generate the password from a user passphrase
+func WithPassphrase(passphrase string) Option {
+	return func(o *Options) {
+		o.Mode = ModePassphrase
+		o.Passphrase = passphrase
+	}
+}
+
+// Number of words. It must be at least 1
+func WithWordCount(count uint) Option {
+	return func(o *Options) {
+		o.WordCount = count
+		o.explicit |= fieldWordCount
+	}
+}
+
+// Minimum and maximum word lengths. 0 = no minimum or no maximum
+func WithWordLength(min, max uint) Option {
+	return func(o *Options) {
+		o.MinWordLength, o.MaxWordLength = min, max
+		o.explicit |= fieldMinWordLength | fieldMaxWordLength
+	}
+}
+
+// Build the passwords from a template, e.g. `Word-word-99-!` or `CvcvC99`
+func WithPattern(pattern string) Option {
+	return func(o *Options) {
+		o.Mode = ModePattern
+		o.Pattern = pattern
+	}
+}
+
+// Use the words of a dictionary. The mode is set to `ModeDict`, unless it is `ModePattern` or `ModePhrase`
+func WithDictionary(dict *Dictionary) Option {
+	return func(o *Options) {
+		if o.Mode != ModePattern && o.Mode != ModePhrase {
+			o.Mode = ModeDict
+		}
+
+		o.Dictionary = dict
+	}
+}
+
+// Use the embedded dictionary of a language. The mode is set to `ModeDict`, unless it is `ModePattern` or `ModePhrase`
+func WithLanguage(lang Language) Option {
+	return func(o *Options) {
+		if o.Mode != ModePattern && o.Mode != ModePhrase {
+			o.Mode = ModeDict
+		}
+
+		o.Language = lang
+	}
+}
+
+// Replace accented letters by their base letter
+func WithoutAccents() Option {
+	return func(o *Options) {
+		o.StripAccents = true
+	}
+}
+
+// Capitalization rule
+func WithCapRule(rule CapRule) Option {
+	return func(o *Options) {
+		o.CapRule = rule
+	}
+}
+
+// Uppercase ratio of `CapRuleRandom`
+func WithCapRatio(ratio float32) Option {
+	return func(o *Options) {
+		o.CapRatio = ratio
+		o.explicit |= fieldCapRatio
+	}
+}
+
+// Separate the words with a fixed character
+func WithSeparator(sep rune) Option {
+	return func(o *Options) {
+		o.SepRule = SepRuleFixed
+		o.Separator = sep
+	}
+}
+
+// Separate the words with random characters of a pool
+func WithSeparatorPool(pool string) Option {
+	return func(o *Options) {
+		o.SepRule = SepRuleRandom
+		o.SeparatorPool = pool
+		o.explicit |= fieldSeparatorPool
+	}
+}
+
+// Don't separate the words
+func WithoutSeparator() Option {
+	return func(o *Options) {
+		o.SepRule = SepRuleNone
+	}
+}
+
+// Add `count` digits at `pos`. With `DigitPosWord`, they are added after each word. 0 = no digits
+func WithDigits(pos DigitPos, count uint) Option {
+	return func(o *Options) {
+		o.DigitPos = pos
+		o.DigitsBefore = 0
+
+		if pos == DigitPosWord {
+			o.DigitsAfter, o.DigitCount = count, 0
+		} else {
+			o.DigitsAfter, o.DigitCount = 0, count
+		}
+
+		o.explicit |= fieldDigitCount
+	}
+}
+
+// Add `count` symbols at `pos`. With `SymbPosWord`, they are added after each word. 0 = no symbols.
+// The symbols are random unless `WithSymbol` is used
+func WithSymbols(pos SymbPos, count uint) Option {
+	return func(o *Options) {
+		if o.SymbRule == "" {
+			o.SymbRule = SymbRuleRandom
+		}
+
+		o.SymbPos = pos
+		o.SymbolsBefore = 0
+
+		if pos == SymbPosWord {
+			o.SymbolsAfter, o.SymbolCount = count, 0
+		} else {
+			o.SymbolsAfter, o.SymbolCount = 0, count
+		}
+
+		o.explicit |= fieldSymbolCount
+	}
+}
+
+// Use a fixed symbol
+func WithSymbol(symbol rune) Option {
+	return func(o *Options) {
+		o.SymbRule = SymbRuleFixed
+		o.Symbol = symbol
+	}
+}
+
+// Use random symbols of a pool. The pool is also used by the random padding
+func WithSymbolPool(pool string) Option {
+	return func(o *Options) {
+		o.SymbRule = SymbRuleRandom
+		o.SymbolPool = pool
+		o.explicit |= fieldSymbolPool
+	}
+}
+
+// Pad the password to `length` characters. 0 = random symbols of the symbol pool
+func WithPadding(length uint, symbol rune) Option {
+	return func(o *Options) {
+		o.PadLength = length
+		o.PadSymbol = symbol
+		o.PadRule = PadRuleFixed
+
+		if symbol == 0 {
+			o.PadRule = PadRuleRandom
+		}
+	}
+}
+
+// 1337 coding ratio
+func WithL33t(ratio float32) Option {
+	return func(o *Options) {
+		o.L33tRatio = ratio
+	}
+}
+
+// 1337 substitution table among the built-in profiles
+func WithL33tProfile(profile L33tProfile) Option {
+	return func(o *Options) {
+		o.L33tProfile = profile
+	}
+}
+
+// Custom 1337 substitution table
+func WithL33tTable(table L33tTable) Option {
+	return func(o *Options) {
+		o.L33tTable = table
+	}
+}
+
+// Maximum password length
+func WithMaxLength(length uint) Option {
+	return func(o *Options) {
+		o.MaxLength = length
+	}
+}
+
+// Minimum entropy in bits. The number of words is chosen to reach it
+func WithMinEntropy(bits float64) Option {
+	return func(o *Options) {
+		o.MinEntropy = bits
+	}
+}
+
+// Minimum edit distance between the passwords generated by `GenPasswords`
+func WithMinEditDistance(distance uint) Option {
+	return func(o *Options) {
+		o.MinEditDistance = distance
+	}
+}
+
+// Policy the passwords must meet, and how passwords that don't are fixed
+func WithPolicy(policy *Policy, fix PolicyFix) Option {
+	return func(o *Options) {
+		o.Policy = policy
+		o.PolicyFix = fix
+	}
+}
+
+// Source of randomness
+func WithRandom(rnd Random) Option {
+	return func(o *Options) {
+		o.Random = rnd
+	}
+}
+
+// Calculate the entropy returned by `GenPassword`
+func WithEntropy() Option {
+	return func(o *Options) {
+		o.CalculateEntropy = true
+	}
+}
@@ -1,36 +1,38 @@
 package mempass
 
 import (
-	"math/rand"
+	"math"
 	"regexp"
 	"strings"
 	"unicode"
 )
 
 type FromPassphrase struct {
-	l33t *L33t
+	l33t    *L33t
+	rnd     Random
+	entropy float64 // Entropy of the random changes made by the last call to `Generate`. The passphrase itself is not accounted
 }
 
 func NewFromPassphrase() *FromPassphrase {
-	return &FromPassphrase{l33t: NewL33t()}
+	return newFromPassphrase(NewSecureRandom())
+}
+
+func newFromPassphrase(rnd Random) *FromPassphrase {
+	return &FromPassphrase{l33t: NewL33t(), rnd: rnd}
 }
 
 func (f *FromPassphrase) Generate(input string) []rune {
 	input = f.preProcessPassphrase(input)
 	l, ucCount, numCount, scCount, lcPos := f.countChars(input)
 
-	// Minimum number of uppercase, numbers and specials chars
-	ratio := 1.0 / 8.0
-	min := int(float64(l) * ratio)
-	if min == 0 {
-		min = 1
-	}
-
 	// Minimum number of uppercase, numbers and specials chars to add
+	min := minPassphraseChars(l)
 	addUc := min - ucCount
 	addNum := min - numCount
 	addSc := min - scCount
 
+	f.entropy = 0
+
 	// Create a RW rune array from the input string
 	runes := toRunes(input)
 	runes = f.addUc(runes, addUc, lcPos)
@@ -40,6 +42,48 @@ func (f *FromPassphrase) Generate(input string) []rune {
 	return runes
 }
 
+// Minimum number of uppercase, numbers and specials chars of a passphrase of `length` characters
+func minPassphraseChars(length int) int {
+	if n := length / 8; n > 0 {
+		return n
+	}
+
+	return 1
+}
+
+// Lowest entropy of the random changes `Generate` makes to a passphrase, whatever the random choices.
+// The letters picked to be uppercased can't be 1337 coded anymore, so they change the digits choice
+func (f *FromPassphrase) minEntropy(input string) float64 {
+	input = f.preProcessPassphrase(input)
+	l, ucCount, numCount, _, lcPos := f.countChars(input)
+	runes := toRunes(input)
+
+	codable := 0
+	for _, pos := range lcPos {
+		if f.l33t.can1337(runes[pos]) {
+			codable++
+		}
+	}
+
+	// Bits of `count` changes among `candidates` positions, the missing ones being appended from an alphabet
+	changeBits := func(count, candidates int, alphabet float64) float64 {
+		done := max(0, min(count, candidates))
+		return float64(max(0, count-done))*math.Log2(alphabet) + log2Binomial(candidates, done)
+	}
+
+	addUc := minPassphraseChars(l) - ucCount
+	addNum := minPassphraseChars(l) - numCount
+	upper := max(0, min(addUc, len(lcPos)))
+
+	// `k` of the uppercased letters could have been 1337 coded
+	lowest := math.Inf(1)
+	for k := max(0, upper-(len(lcPos)-codable)); k <= min(upper, codable); k++ {
+		lowest = math.Min(lowest, changeBits(addNum, codable-k, 10))
+	}
+
+	return changeBits(addUc, len(lcPos), 26) + lowest
+}
+
 func (f *FromPassphrase) countChars(s string) (l, uc, num, sc int, lcPos []int) {
 	runes := toRunes(s)
 	l = len(runes)
@@ -73,11 +117,12 @@ func (f *FromPassphrase) preProcessPassphrase(input string) string {
 
 func (f *FromPassphrase) addUc(runes []rune, count int, lcPos []int) []rune {
 	done := 0
+	candidates := len(lcPos)
 
 	if len(lcPos) > 0 {
-		for i := 0; i < count; i++ {
+		for i := 0; i < count && len(lcPos) > 0; i++ {
 			// Pick a random run
-			idx := rand.Intn(len(lcPos))
+			idx := f.rnd.Intn(len(lcPos))
 			pos := lcPos[idx]
 			char := runes[pos]
 
@@ -98,11 +143,15 @@ func (f *FromPassphrase) addUc(runes []rune, count int, lcPos []int) []rune {
 
 	if done < count {
 		for i := done + 1; i <= count; i++ {
-			idx := rand.Intn(26)
+			idx := f.rnd.Intn(26)
 			runes = append(runes, rune(ALPHABET_UPPER[idx]))
 		}
+
+		f.entropy += float64(count-done) * math.Log2(26)
 	}
 
+	f.entropy += log2Binomial(candidates, done)
+
 	return runes
 }
 
@@ -111,15 +160,16 @@ func (f *FromPassphrase) addNums(runes []rune, count int, lcPos []int) []rune {
 
 	// Find all l33table characters positions
 	l33table := f.find1337able(runes)
+	candidates := len(l33table)
 
 	if len(l33table) > 0 {
-		for i := 0; i < count; i++ {
+		for i := 0; i < count && len(l33table) > 0; i++ {
 			// Get a random position from the l33table characters positions array
-			idx := rand.Intn(len(l33table))
+			idx := f.rnd.Intn(len(l33table))
 			pos := l33table[idx]
 
 			// Transform the character
-			runes[pos] = rune(f.l33t.make1337(runes[pos], 0))
+			runes[pos] = f.l33t.make1337(runes[pos])
 
 			// Remove the l33ted character from the l33table array
 			l33table = append(l33table[:idx], l33table[idx+1:]...)
@@ -130,11 +180,15 @@ func (f *FromPassphrase) addNums(runes []rune, count int, lcPos []int) []rune {
 
 	if done < count {
 		for i := done + 1; i <= count; i++ {
-			idx := rand.Intn(10)
+			idx := f.rnd.Intn(10)
 			runes = append(runes, rune(NUMBERS[idx]))
 		}
+
+		f.entropy += float64(count-done) * math.Log2(10)
 	}
 
+	f.entropy += log2Binomial(candidates, done)
+
 	return runes
 }
 
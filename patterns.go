@@ -0,0 +1,448 @@
+package mempass
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Shortest dictionary word matched by `Analyze`
+const minWordMatch = 3
+
+// Rows of a QWERTY keyboard, unshifted and shifted
+var keyboardRows = [][2]string{
+	{"`1234567890-=", "~!@#$%^&*()_+"},
+	{"qwertyuiop[]\\", "QWERTYUIOP{}|"},
+	{"asdfghjkl;'", "ASDFGHJKL:\""},
+	{"zxcvbnm,./", "ZXCVBNM<>?"},
+}
+
+// Horizontal position of the first key of each row, as rows are staggered
+var keyboardRowOffsets = []float64{0, 1.5, 1.75, 2.25}
+
+// Position of a key on the keyboard
+type keyPos struct {
+	row     int
+	x       float64
+	shifted bool
+}
+
+var keyboard = func() map[rune]keyPos {
+	keys := make(map[rune]keyPos)
+
+	for r, row := range keyboardRows {
+		for shifted, chars := range row {
+			for c, char := range toRunes(chars) {
+				keys[char] = keyPos{row: r, x: keyboardRowOffsets[r] + float64(c), shifted: shifted == 1}
+			}
+		}
+	}
+
+	return keys
+}()
+
+// Number of keys, and average number of neighbors of a key
+var keyboardStarts, keyboardDegree = func() (float64, float64) {
+	var keys, neighbors float64
+
+	for char, a := range keyboard {
+		if a.shifted {
+			continue
+		}
+
+		keys++
+
+		for other, b := range keyboard {
+			if other != char && !b.shifted && keyboardDirection(a, b) != 0 {
+				neighbors++
+			}
+		}
+	}
+
+	return keys, neighbors / keys
+}()
+
+// Direction from a key to a neighbor key, 0 if they are not neighbors
+func keyboardDirection(a, b keyPos) int {
+	dx := b.x - a.x
+
+	switch b.row - a.row {
+	case 0:
+		if dx == 1 {
+			return 1
+		} else if dx == -1 {
+			return 2
+		}
+	case -1, 1:
+		if math.Abs(dx) <= .75 {
+			direction := 3
+			if dx > 0 {
+				direction++
+			}
+
+			if b.row > a.row {
+				direction += 2
+			}
+
+			return direction
+		}
+	}
+
+	return 0
+}
+
+// Find all the parts of a password matching a pattern
+func findMatches(pwd []rune, dicts []*Dictionary) []*Match {
+	var matches []*Match
+
+	for _, find := range []func([]rune) []*Match{
+		func(pwd []rune) []*Match { return dictionaryMatches(pwd, dicts) },
+		keyboardMatches,
+		repeatMatches,
+		sequenceMatches,
+		dateMatches,
+	} {
+		matches = append(matches, find(pwd)...)
+	}
+
+	sortMatches(matches)
+
+	return matches
+}
+
+// Check if a word is a common password or a word of a dictionary
+func isKnownWord(word string, dicts []*Dictionary) bool {
+	if _, exists := commonPasswordRanks()[word]; exists {
+		return true
+	}
+
+	for _, dict := range dicts {
+		if _, exists := dict.ranks()[word]; exists {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Find the dictionary words and common passwords
+func dictionaryMatches(pwd []rune, dicts []*Dictionary) []*Match {
+	return wordMatches(pwd, func(word string) bool {
+		return isKnownWord(word, dicts)
+	})
+}
+
+// Find the parts of a password that are known words, in any case, or in one of the 1337 readings of the password
+func wordMatches(pwd []rune, known func(string) bool) []*Match {
+	var matches []*Match
+	var readings [][]rune
+
+	for _, reading := range NewL33t().Decode(string(pwd)) {
+		readings = append(readings, toRunes(reading))
+	}
+
+	lower := make([]rune, len(pwd))
+	for i, char := range pwd {
+		lower[i] = unicode.ToLower(char)
+	}
+
+	for i := range pwd {
+		for j := i + minWordMatch; j <= len(pwd); j++ {
+			m := &Match{Pattern: MatchDictionary, Token: string(pwd[i:j]), Start: i, End: j}
+
+			if word := string(lower[i:j]); known(word) {
+				m.Word = word
+				matches = append(matches, m)
+				continue
+			}
+
+			for _, reading := range readings {
+				if word := string(reading[i:j]); word != string(lower[i:j]) && known(word) {
+					m.Word, m.L33t = word, true
+					matches = append(matches, m)
+					break
+				}
+			}
+		}
+	}
+
+	return matches
+}
+
+// Find the walks of 3 keys at least along adjacent keys
+func keyboardMatches(pwd []rune) []*Match {
+	var matches []*Match
+
+	for i := 0; i < len(pwd); {
+		j := i + 1
+		for j < len(pwd) && isKeyboardStep(pwd[j-1], pwd[j]) {
+			j++
+		}
+
+		if j-i >= 3 {
+			matches = append(matches, &Match{Pattern: MatchKeyboard, Token: string(pwd[i:j]), Start: i, End: j})
+		}
+
+		i = max(j-1, i+1)
+	}
+
+	return matches
+}
+
+func isKeyboardStep(from, to rune) bool {
+	a, aExists := keyboard[from]
+	b, bExists := keyboard[to]
+
+	return aExists && bExists && keyboardDirection(a, b) != 0
+}
+
+// Number of direction changes of a keyboard walk, plus 1
+func keyboardTurns(token []rune) int {
+	turns, last := 0, 0
+
+	for i := 1; i < len(token); i++ {
+		direction := keyboardDirection(keyboard[token[i-1]], keyboard[token[i]])
+		if direction != last {
+			turns++
+			last = direction
+		}
+	}
+
+	return turns
+}
+
+// Number of walks of the same length with as many turns or less, from any key
+func keyboardGuesses(token []rune) float64 {
+	guesses := 0.0
+	turns := keyboardTurns(token)
+
+	for i := 2; i <= len(token); i++ {
+		for j := 1; j <= min(turns, i-1); j++ {
+			guesses += binomial(i-1, j-1) * keyboardStarts * math.Pow(keyboardDegree, float64(j))
+		}
+	}
+
+	var shifted, unshifted int
+	for _, char := range token {
+		if keyboard[char].shifted {
+			shifted++
+		} else {
+			unshifted++
+		}
+	}
+
+	if shifted > 0 && unshifted == 0 {
+		guesses *= 2
+	} else if shifted > 0 {
+		variations := 0.0
+		for i := 1; i <= min(shifted, unshifted); i++ {
+			variations += binomial(shifted+unshifted, i)
+		}
+
+		guesses *= variations
+	}
+
+	return guesses
+}
+
+// Find the characters or strings repeated twice at least. The longest repeat starting at each position is kept
+func repeatMatches(pwd []rune) []*Match {
+	var matches []*Match
+
+	for i := 0; i < len(pwd); {
+		length := 0
+
+		for base := 1; i+2*base <= len(pwd); base++ {
+			end := i + base
+			for end+base <= len(pwd) && slices.Equal(pwd[end:end+base], pwd[i:i+base]) {
+				end += base
+			}
+
+			if end-i > length && end-i >= 2*base {
+				length = end - i
+			}
+		}
+
+		if length == 0 {
+			i++
+			continue
+		}
+
+		matches = append(matches, &Match{Pattern: MatchRepeat, Token: string(pwd[i : i+length]), Start: i, End: i + length})
+		i += length
+	}
+
+	return matches
+}
+
+// Shortest string whose repetition is the token
+func repeatBase(token []rune) []rune {
+	for base := 1; base < len(token); base++ {
+		if len(token)%base == 0 && strings.Repeat(string(token[:base]), len(token)/base) == string(token) {
+			return token[:base]
+		}
+	}
+
+	return token
+}
+
+// Find the runs of 3 characters at least of the same class, going up or down by the same step, e.g. `abc`, `7531` or `ACEG`
+func sequenceMatches(pwd []rune) []*Match {
+	var matches []*Match
+
+	for i := 0; i+2 < len(pwd); {
+		delta := pwd[i+1] - pwd[i]
+		j := i + 1
+
+		for j+1 < len(pwd) && pwd[j+1]-pwd[j] == delta {
+			j++
+		}
+
+		if j-i >= 2 && delta != 0 && delta >= -5 && delta <= 5 && sameClass(pwd[i:j+1]) {
+			matches = append(matches, &Match{Pattern: MatchSequence, Token: string(pwd[i : j+1]), Start: i, End: j + 1})
+		}
+
+		i = j
+	}
+
+	return matches
+}
+
+func sameClass(chars []rune) bool {
+	for _, is := range []func(rune) bool{unicode.IsLower, unicode.IsUpper, unicode.IsDigit} {
+		all := true
+		for _, char := range chars {
+			all = all && is(char)
+		}
+
+		if all {
+			return true
+		}
+	}
+
+	return false
+}
+
+// A date with separators, e.g. `13/05/1987` or `1987-5-13`
+var dateWithSeparator = regexp.MustCompile(`^(\d{1,4})([\s/\\_.-])(\d{1,2})([\s/\\_.-])(\d{1,4})$`)
+
+// Orders of the day, month and year of the dates without separators, by length
+var dateSplits = map[int][]string{
+	6: {"ddmmyy", "mmddyy", "yymmdd"},
+	8: {"ddmmyyyy", "mmddyyyy", "yyyymmdd"},
+}
+
+// Find the years and the dates, with or without separators
+func dateMatches(pwd []rune) []*Match {
+	var matches []*Match
+
+	for i := range pwd {
+		for j := i + 4; j <= min(len(pwd), i+10); j++ {
+			token := string(pwd[i:j])
+			m := &Match{Pattern: MatchDate, Token: token, Start: i, End: j}
+
+			if sub := dateWithSeparator.FindStringSubmatch(token); sub != nil && sub[2] == sub[4] {
+				m.year, m.separator, m.monthDay = parseDate(sub[1], sub[3], sub[5]), true, true
+				if m.year != 0 {
+					matches = append(matches, m)
+				}
+
+				continue
+			}
+
+			if strings.Trim(token, NUMBERS) != "" {
+				continue
+			}
+
+			if len(token) == 4 {
+				if year, _ := strconv.Atoi(token); year >= 1900 && year <= 2099 {
+					m.year = year
+					matches = append(matches, m)
+				}
+
+				continue
+			}
+
+			for _, split := range dateSplits[len(token)] {
+				parts := map[byte]string{}
+				for k := range split {
+					parts[split[k]] += token[k : k+1]
+				}
+
+				if year := parseDate(parts['d'], parts['m'], parts['y']); year != 0 {
+					m.year, m.monthDay = year, true
+					matches = append(matches, m)
+					break
+				}
+			}
+		}
+	}
+
+	return matches
+}
+
+// Parse the day, month and year of a date, in any order but with the year first or last.
+// The returned year is 0 if it is not a valid date
+func parseDate(a, b, c string) int {
+	for _, order := range [][3]string{{a, b, c}, {b, a, c}, {b, c, a}, {c, b, a}} {
+		day, _ := strconv.Atoi(order[0])
+		month, _ := strconv.Atoi(order[1])
+		year, _ := strconv.Atoi(order[2])
+
+		if day < 1 || day > 31 || month < 1 || month > 12 || len(order[0]) > 2 || len(order[1]) > 2 {
+			continue
+		}
+
+		switch len(order[2]) {
+		case 2:
+			if year > 50 {
+				year += 1900
+			} else {
+				year += 2000
+			}
+		case 4:
+			if year < 1000 || year > 2099 {
+				continue
+			}
+		default:
+			continue
+		}
+
+		return year
+	}
+
+	return 0
+}
+
+// Number of dates or years as close to the current year
+func dateGuesses(m *Match) float64 {
+	years := math.Max(math.Abs(float64(m.year-time.Now().Year())), 20)
+	if !m.monthDay {
+		return years
+	}
+
+	guesses := 365 * years
+	if m.separator {
+		guesses *= 4
+	}
+
+	return guesses
+}
+
+// Most common passwords, the most common first
+var commonPasswords = []string{
+	"123456", "password", "12345678", "qwerty", "123456789", "12345", "1234", "111111", "1234567", "dragon",
+	"123123", "baseball", "abc123", "football", "monkey", "letmein", "696969", "shadow", "master", "666666",
+	"qwertyuiop", "123321", "mustang", "1234567890", "michael", "654321", "superman", "1qaz2wsx", "7777777", "121212",
+	"000000", "qazwsx", "123qwe", "killer", "trustno1", "jordan", "jennifer", "zxcvbnm", "asdfgh", "hunter",
+	"buster", "soccer", "harley", "batman", "andrew", "tigger", "sunshine", "iloveyou", "charlie", "robert",
+	"thomas", "hockey", "ranger", "daniel", "starwars", "112233", "george", "computer", "michelle", "jessica",
+	"pepper", "1111", "zxcvbn", "555555", "11111111", "131313", "freedom", "777777", "pass", "maggie",
+	"159753", "aaaaaa", "ginger", "princess", "joshua", "cheese", "amanda", "summer", "love", "ashley",
+	"nicole", "chelsea", "biteme", "matthew", "access", "yankees", "987654321", "dallas", "austin", "thunder",
+	"taylor", "matrix", "welcome", "admin", "login", "qwerty123", "solo", "abcdef", "hello", "welcome1",
+}
@@ -0,0 +1,179 @@
+package mempass
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Part of speech of a word of a tagged dictionary
+type PartOfSpeech string
+
+const (
+	PosAdjective  PartOfSpeech = "adjective"
+	PosNoun       PartOfSpeech = "noun"
+	PosPluralNoun PartOfSpeech = "plural_noun"
+	PosVerb       PartOfSpeech = "verb" // In the past tense, so it agrees with singular and plural nouns
+	PosAdverb     PartOfSpeech = "adverb"
+	PosNumber     PartOfSpeech = "number" // A number from 2 to 99, in digits. It is not a tag of the dictionary
+)
+
+// Parts of speech of the words of a phrase, separated by spaces, e.g. "adjective noun verb adverb"
+type Phrase string
+
+const (
+	PhraseAdjNounVerbAdv    Phrase = "adjective noun verb adverb"        // e.g. `brave-otter-jumped-quickly`
+	PhraseNumberAdjNounVerb Phrase = "number adjective plural_noun verb" // e.g. `27-brave-otters-jumped`
+)
+
+// Smallest and largest numbers of `PosNumber`
+const (
+	minPhraseNumber = 2
+	maxPhraseNumber = 99
+)
+
+// Check if a part of speech can tag the words of a dictionary
+func isTag(pos PartOfSpeech) bool {
+	switch pos {
+	case PosAdjective, PosNoun, PosPluralNoun, PosVerb, PosAdverb:
+		return true
+	}
+
+	return false
+}
+
+// A compiled `Phrase`: the words each word of the phrase is picked among
+type phrase struct {
+	pools  []*wordIndex
+	minLen uint // Length of the shortest word
+}
+
+// Tagged dictionary of the options: `Dictionary`, or the embedded tagged dictionary of `Language`
+func (g *Generator) taggedDictionary() (*Dictionary, error) {
+	dict := g.opt.Dictionary
+	if dict == nil {
+		var err error
+		if dict, err = LoadTaggedLanguageDictionary(g.opt.Language); err != nil {
+			return nil, optionError("Language", g.opt.Language, ErrUnsupportedValue, "has no tagged dictionary for parts of speech, use a `Dictionary` loaded with `NewTaggedDictionary`")
+		}
+	}
+
+	if dict.tags == nil {
+		return nil, optionError("Dictionary", dict, ErrConflict, "is not tagged with parts of speech, load it with `NewTaggedDictionary`")
+	}
+
+	if g.opt.StripAccents {
+		dict = dict.WithoutAccents()
+	}
+
+	return dict, nil
+}
+
+// Compile `Phrase` with the tagged dictionary of the options
+func (g *Generator) compilePhrase() (*phrase, error) {
+	dict, err := g.taggedDictionary()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &phrase{}
+
+	for _, part := range strings.Fields(string(g.opt.Phrase)) {
+		pos := PartOfSpeech(part)
+
+		var pool []string
+		if pos == PosNumber {
+			pool = phraseNumbers
+		} else if isTag(pos) {
+			pool = dict.tags[pos]
+		} else {
+			return nil, optionError("Phrase", g.opt.Phrase, ErrUnsupportedValue, "has an unknown part of speech `"+part+"`")
+		}
+
+		if len(pool) == 0 {
+			return nil, optionError("Phrase", g.opt.Phrase, ErrEmptyPool, "has a part of speech without any word in the dictionary: `"+part+"`")
+		}
+
+		index := newWordIndex(pool)
+		p.pools = append(p.pools, index)
+
+		if l := shortestLength(index); p.minLen == 0 || l < p.minLen {
+			p.minLen = l
+		}
+	}
+
+	if len(p.pools) == 0 {
+		return nil, optionError("Phrase", g.opt.Phrase, ErrMissingOption, "must have one part of speech at least")
+	}
+
+	return p, nil
+}
+
+// Numbers of `PosNumber`
+var phraseNumbers = func() []string {
+	var numbers []string
+	for n := minPhraseNumber; n <= maxPhraseNumber; n++ {
+		numbers = append(numbers, strconv.Itoa(n))
+	}
+
+	return numbers
+}()
+
+// Pick a word of each part of speech of the phrase. If `budget` is not 0, the words don't have more than `budget` letters in total
+func (g *Generator) phraseWords(budget uint) [][]rune {
+	words := make([][]rune, 0, len(g.phrase.pools))
+
+	for range g.phrase.pools {
+		pool := g.phrase.pool(budget, words)
+		words = append(words, toRunes(pool[g.rnd.Intn(len(pool))]))
+	}
+
+	return words
+}
+
+// Words the next word of the phrase is picked among, once the words `picked`
+func (p *phrase) pool(budget uint, picked [][]rune) []string {
+	return p.pools[len(picked)].lookup(0, p.maxLength(budget, picked))
+}
+
+// Maximum length of the next word of the phrase, so that the words already `picked` and the shortest words
+// of the next parts of speech fit in `budget` letters. 0 means no limit
+func (p *phrase) maxLength(budget uint, picked [][]rune) uint {
+	if budget == 0 {
+		return 0
+	}
+
+	reserved := p.minLetters(len(picked) + 1)
+	for _, word := range picked {
+		reserved += uint(len(word))
+	}
+
+	// Never more than the longest word of the part of speech
+	return min(budget-reserved, uint(len(p.pools[len(picked)].offsets)-2))
+}
+
+// Number of letters of the shortest words of the parts of speech, from the `from`th one
+func (p *phrase) minLetters(from int) uint {
+	letters := uint(0)
+	for _, pool := range p.pools[from:] {
+		letters += shortestLength(pool)
+	}
+
+	return letters
+}
+
+// Length of the shortest word of an index
+func shortestLength(index *wordIndex) uint {
+	return uint(len(toRunes(index.words[0])))
+}
+
+// Entropy of the choice of the words of the phrase, from the pool of each part of speech.
+// With a `budget`, each word is picked among the words that fit in the remaining budget
+func (p *phrase) entropy(words [][]rune, budget uint) float64 {
+	bits := 0.0
+	for i := range words {
+		bits += math.Log2(float64(len(p.pool(budget, words[:i]))))
+	}
+
+	return bits
+}
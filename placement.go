@@ -0,0 +1,134 @@
+package mempass
+
+import "errors"
+
+// Insert `count` runes returned by `char` at random positions inside the words, never before the first or after the last letter.
+// The words are copied, `words` is not modified
+func (g *Generator) insertInside(words [][]rune, count uint, char func() rune) ([][]rune, error) {
+	newWords := make([][]rune, len(words))
+	for i, word := range words {
+		newWords[i] = append([]rune(nil), word...)
+	}
+
+	for n := uint(0); n < count; n++ {
+		slots := wordsInnerSlots(newWords)
+
+		if slots == 0 {
+			return nil, errors.New("Words are too short to insert a character inside")
+		}
+
+		// Every inner position of the password has the same probability of being picked
+		pos := g.rnd.Intn(slots)
+		c := char()
+
+		for i, word := range newWords {
+			if pos < innerSlots(word) {
+				newWords[i] = insertRune(word, pos+1, c)
+				break
+			}
+
+			pos -= innerSlots(word)
+		}
+	}
+
+	return newWords, nil
+}
+
+// Append `DigitCount` digits to randomly chosen words.
+// Every way of spreading the digits over the words has the same probability
+func (g *Generator) spreadDigits(words [][]rune) {
+	k := int(g.opt.DigitCount)
+
+	// Stars and bars: the digits take `k` of the `n+k-1` places, the other places are the boundaries between words
+	word := 0
+	for _, isDigit := range g.pickPlaces(len(words)+k-1, k) {
+		if !isDigit {
+			word++
+			continue
+		}
+
+		// Never append to the underlying array, it may be shared with the words before processing
+		w := words[word]
+		words[word] = append(w[:len(w):len(w)], g.randBytesFrom(1, NUMBERS)[0])
+	}
+}
+
+// Pick `k` places among `n`. Every combination has the same probability
+func (g *Generator) pickPlaces(n, k int) []bool {
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+
+	picked := make([]bool, n)
+
+	for i := 0; i < k; i++ {
+		j := i + g.rnd.Intn(n-i)
+		idx[i], idx[j] = idx[j], idx[i]
+		picked[idx[i]] = true
+	}
+
+	return picked
+}
+
+// Number of positions where a rune can be inserted inside a word
+func innerSlots(word []rune) int {
+	if len(word) < 2 {
+		return 0
+	}
+
+	return len(word) - 1
+}
+
+// Number of positions where a rune can be inserted inside the words
+func wordsInnerSlots(words [][]rune) int {
+	slots := 0
+	for _, word := range words {
+		slots += innerSlots(word)
+	}
+
+	return slots
+}
+
+// Return a copy of `word` where `char` is inserted at `pos`
+func insertRune(word []rune, pos int, char rune) []rune {
+	newWord := make([]rune, 0, len(word)+1)
+	newWord = append(newWord, word[:pos]...)
+	newWord = append(newWord, char)
+
+	return append(newWord, word[pos:]...)
+}
+
+// Number of symbols added to a password of `count` words
+func (g *Generator) symbolCount(count uint) uint {
+	switch g.opt.SymbPos {
+	case SymbPosWord:
+		return count * (g.opt.SymbolsBefore + g.opt.SymbolsAfter)
+
+	case SymbPosBetween:
+		if count < 2 {
+			return 0
+		}
+
+		return (count - 1) * g.opt.SymbolCount
+	}
+
+	return g.opt.SymbolCount
+}
+
+// Number of digits added to a password of `count` words
+func (g *Generator) digitCount(count uint) uint {
+	switch g.opt.DigitPos {
+	case DigitPosWord:
+		return count * (g.opt.DigitsBefore + g.opt.DigitsAfter)
+
+	case DigitPosSeparator:
+		if count < 2 {
+			return 0
+		}
+
+		return (count - 1) * g.opt.DigitCount
+	}
+
+	return g.opt.DigitCount
+}
@@ -0,0 +1,340 @@
+package mempass
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type PolicyFix string
+
+const (
+	PolicyFixRegenerate PolicyFix = "regenerate" // Generate a new password until one meets the policy
+	PolicyFixRepair     PolicyFix = "repair"     // Change the password to add the missing character classes and length, generate a new one if it's not enough
+)
+
+// Maximum number of passwords generated to meet a policy
+const maxPolicyAttempts = 1000
+
+// Reads the 1337 coded banned substrings. It is never changed, so all checks share it
+var policyL33t = NewL33t()
+
+// Errors reported by `Policy.Check`. Use `errors.Is` to know which rules are violated
+var (
+	ErrTooShort          = errors.New("password is too short")
+	ErrTooLong           = errors.New("password is too long")
+	ErrMissingLower      = errors.New("password does not contain enough lowercase letters")
+	ErrMissingUpper      = errors.New("password does not contain enough uppercase letters")
+	ErrMissingDigit      = errors.New("password does not contain enough digits")
+	ErrMissingSymbol     = errors.New("password does not contain enough symbols")
+	ErrMissingClasses    = errors.New("password does not contain enough character classes")
+	ErrForbiddenChar     = errors.New("password contains a forbidden character")
+	ErrRepeatedRun       = errors.New("password repeats the same character too many times in a row")
+	ErrBannedSubstring   = errors.New("password contains a banned substring")
+	ErrPolicyUnreachable = errors.New("cannot generate a password that meets the policy")
+)
+
+// Constraints a password must meet, usually imposed by the system it is created for.
+// Zero values mean no constraint
+type Policy struct {
+	MinLength        uint     // Minimum length, in characters
+	MaxLength        uint     // Maximum length, in characters
+	MinLower         uint     // Minimum number of lowercase letters
+	MinUpper         uint     // Minimum number of uppercase letters
+	MinDigits        uint     // Minimum number of digits
+	MinSymbols       uint     // Minimum number of symbols, i.e. characters that are neither letters nor digits
+	MinClasses       uint     // Minimum number of character classes (lowercase, uppercase, digits, symbols) among 4
+	ForbiddenChars   string   // Characters the password must not contain
+	MaxRepeat        uint     // Maximum number of times the same character can be repeated in a row
+	BannedSubstrings []string // Substrings the password must not contain, case insensitive, even 1337 coded
+}
+
+// A rule of the policy violated by a password
+type PolicyViolation struct {
+	Err    error  // One of the `Err*` policy errors
+	Detail string // What was found in the password
+}
+
+func (v *PolicyViolation) Error() string {
+	return v.Err.Error() + ": " + v.Detail
+}
+
+func (v *PolicyViolation) Unwrap() error {
+	return v.Err
+}
+
+// All the rules of the policy violated by a password
+type PolicyError struct {
+	Violations []*PolicyViolation
+}
+
+func (e *PolicyError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+func (e *PolicyError) Unwrap() []error {
+	errs := make([]error, len(e.Violations))
+	for i, v := range e.Violations {
+		errs[i] = v
+	}
+
+	return errs
+}
+
+// Number of characters of each class in a password
+type classCounts struct {
+	lower, upper, digits, symbols uint
+}
+
+func countClasses(pwd []rune) classCounts {
+	var c classCounts
+
+	for _, char := range pwd {
+		switch {
+		case unicode.IsLower(char):
+			c.lower++
+		case unicode.IsUpper(char):
+			c.upper++
+		case unicode.IsDigit(char):
+			c.digits++
+		case !unicode.IsLetter(char):
+			c.symbols++
+		}
+	}
+
+	return c
+}
+
+// Number of classes with at least one character
+func (c classCounts) classes() uint {
+	n := uint(0)
+
+	for _, count := range []uint{c.lower, c.upper, c.digits, c.symbols} {
+		if count > 0 {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Check that a password meets the policy. The returned error is a `*PolicyError` listing every violated rule
+func (p *Policy) Check(password string) error {
+	var violations []*PolicyViolation
+	violate := func(err error, format string, args ...any) {
+		violations = append(violations, &PolicyViolation{Err: err, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	pwd := toRunes(password)
+	length := uint(len(pwd))
+
+	if length < p.MinLength {
+		violate(ErrTooShort, "%d characters, want at least %d", length, p.MinLength)
+	}
+
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violate(ErrTooLong, "%d characters, want at most %d", length, p.MaxLength)
+	}
+
+	c := countClasses(pwd)
+
+	if c.lower < p.MinLower {
+		violate(ErrMissingLower, "%d, want at least %d", c.lower, p.MinLower)
+	}
+
+	if c.upper < p.MinUpper {
+		violate(ErrMissingUpper, "%d, want at least %d", c.upper, p.MinUpper)
+	}
+
+	if c.digits < p.MinDigits {
+		violate(ErrMissingDigit, "%d, want at least %d", c.digits, p.MinDigits)
+	}
+
+	if c.symbols < p.MinSymbols {
+		violate(ErrMissingSymbol, "%d, want at least %d", c.symbols, p.MinSymbols)
+	}
+
+	if c.classes() < p.MinClasses {
+		violate(ErrMissingClasses, "%d, want at least %d", c.classes(), p.MinClasses)
+	}
+
+	forbidden := make(map[rune]bool)
+	for _, char := range pwd {
+		if strings.ContainsRune(p.ForbiddenChars, char) && !forbidden[char] {
+			forbidden[char] = true
+			violate(ErrForbiddenChar, "%q", char)
+		}
+	}
+
+	if p.MaxRepeat > 0 {
+		run := uint(0)
+
+		for i, char := range pwd {
+			if i > 0 && char == pwd[i-1] {
+				run++
+			} else {
+				run = 1
+			}
+
+			if run == p.MaxRepeat+1 {
+				violate(ErrRepeatedRun, "%q repeated more than %d times", char, p.MaxRepeat)
+			}
+		}
+	}
+
+	// Banned substrings are also searched in the 1337 readings of the password, e.g. `p4ssw0rd`
+	readings := append([]string{strings.ToLower(password)}, policyL33t.Decode(password)...)
+	for _, banned := range p.BannedSubstrings {
+		if banned == "" {
+			continue
+		}
+
+		for _, reading := range readings {
+			if strings.Contains(reading, strings.ToLower(banned)) {
+				violate(ErrBannedSubstring, "%q", banned)
+				break
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return &PolicyError{Violations: violations}
+	}
+
+	return nil
+}
+
+// Add the character classes required by the policy that are missing from the password.
+// Letters are changed to uppercase or lowercase, digits and symbols are inserted at random positions.
+// A password shorter than `MinLength` also gets random lowercase letters
+func (g *Generator) repair(pwd []rune) []rune {
+	p := g.opt.Policy
+	c := countClasses(pwd)
+
+	need := classCounts{
+		lower:   missing(c.lower, p.MinLower),
+		upper:   missing(c.upper, p.MinUpper),
+		digits:  missing(c.digits, p.MinDigits),
+		symbols: missing(c.symbols, p.MinSymbols),
+	}
+
+	// Add the missing classes, the ones that change the password the least first
+	for _, class := range []struct {
+		count uint
+		need  *uint
+	}{{c.digits, &need.digits}, {c.upper, &need.upper}, {c.symbols, &need.symbols}, {c.lower, &need.lower}} {
+		if withNeeded(c, need).classes() >= p.MinClasses {
+			break
+		}
+
+		if class.count+*class.need == 0 {
+			*class.need = 1
+		}
+	}
+
+	pwd = append([]rune(nil), pwd...)
+
+	// A letter only changes case if it's not the last of its class, the letters that cannot change are inserted
+	extra := g.changeCase(pwd, need.upper, unicode.IsLower, unicode.ToUpper, surplus(c.lower, p.MinLower), ALPHABET_UPPER)
+	c = countClasses(pwd)
+	extra = append(extra, g.changeCase(pwd, need.lower, unicode.IsUpper, unicode.ToLower, surplus(c.upper+uint(len(extra)), p.MinUpper), ALPHABET_LOWER)...)
+	extra = append(extra, g.randBytesFrom(need.digits, NUMBERS)...)
+
+	pool := allowedChars(p, g.opt.SymbolPool)
+
+	if pool != "" {
+		extra = append(extra, g.randBytesFrom(need.symbols, pool)...)
+	}
+
+	letters := allowedChars(p, ALPHABET_LOWER)
+	if short := missing(uint(len(pwd)+len(extra)), p.MinLength); short > 0 && letters != "" {
+		extra = append(extra, g.randBytesFrom(short, letters)...)
+	}
+
+	// Insert the characters in a random order at random positions, in a single pass
+	for i := len(extra) - 1; i > 0; i-- {
+		j := g.rnd.Intn(i + 1)
+		extra[i], extra[j] = extra[j], extra[i]
+	}
+
+	repaired := make([]rune, 0, len(pwd)+len(extra))
+	for _, isExtra := range g.pickPlaces(len(pwd)+len(extra), len(extra)) {
+		if isExtra {
+			repaired, extra = append(repaired, extra[0]), extra[1:]
+		} else {
+			repaired, pwd = append(repaired, pwd[0]), pwd[1:]
+		}
+	}
+
+	return repaired
+}
+
+// Counts once the needed characters are added
+func withNeeded(c, need classCounts) classCounts {
+	return classCounts{c.lower + need.lower, c.upper + need.upper, c.digits + need.digits, c.symbols + need.symbols}
+}
+
+// Change the case of `n` random letters matching `is`, `changeable` letters at most. Random letters
+// of `alphabet` are returned for the ones that cannot be changed, they have to be inserted instead
+func (g *Generator) changeCase(pwd []rune, n uint, is func(rune) bool, to func(rune) rune, changeable uint, alphabet string) []rune {
+	var candidates []int
+
+	for i, char := range pwd {
+		// Some letters have no uppercase or lowercase form
+		if is(char) && to(char) != char {
+			candidates = append(candidates, i)
+		}
+	}
+
+	changed := min(n, changeable, uint(len(candidates)))
+
+	for i, picked := range g.pickPlaces(len(candidates), int(changed)) {
+		if picked {
+			pwd[candidates[i]] = to(pwd[candidates[i]])
+		}
+	}
+
+	return g.randBytesFrom(n-changed, alphabet)
+}
+
+// Return a function reporting whether the policy allows a character
+func isAllowedBy(p *Policy) func(rune) bool {
+	return func(char rune) bool {
+		return !strings.ContainsRune(p.ForbiddenChars, char)
+	}
+}
+
+// Characters of `s` the policy allows
+func allowedChars(p *Policy, s string) string {
+	return strings.Map(func(char rune) rune {
+		if !isAllowedBy(p)(char) {
+			return -1
+		}
+
+		return char
+	}, s)
+}
+
+// Number of characters missing to reach `min`
+func missing(count, min uint) uint {
+	if count >= min {
+		return 0
+	}
+
+	return min - count
+}
+
+// Number of letters of a class that can change case, keeping `min` of them and one at least
+func surplus(count, min uint) uint {
+	if min = max(min, 1); count <= min {
+		return 0
+	}
+
+	return count - min
+}
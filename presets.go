@@ -0,0 +1,126 @@
+package mempass
+
+import (
+	"errors"
+	"sort"
+)
+
+const (
+	PresetNIST         = "nist-800-63b"
+	PresetADComplexity = "ad-complexity"
+	PresetPCIDSS       = "pci-dss"
+	PresetAWSIAM       = "aws-iam"
+	PresetWPA2         = "wpa2"
+	PresetPIN          = "pin"
+)
+
+// Options and policy suited to a common target system
+type Preset struct {
+	Name        string
+	Description string
+	Options     Options
+}
+
+var presets = map[string]Preset{
+	PresetNIST: {
+		Description: "NIST SP 800-63B memorized secret: at least 15 characters, no composition rules",
+		Options: Options{
+			WordCount: 4,
+			Policy:    &Policy{MinLength: 15, MaxLength: 64},
+		},
+	},
+	PresetADComplexity: {
+		Description: "Active Directory password complexity: at least 8 characters from 3 of the 4 character classes",
+		Options: Options{
+			WordCount:  3,
+			CapRule:    CapRuleFirstLetter,
+			DigitPos:   DigitPosEnd,
+			DigitCount: 2,
+			Policy:     &Policy{MinLength: 8, MaxLength: 127, MinClasses: 3},
+		},
+	},
+	PresetPCIDSS: {
+		Description: "PCI DSS v4.0 requirement 8.3.6: at least 12 characters with both letters and digits",
+		Options: Options{
+			WordCount:  3,
+			DigitPos:   DigitPosEnd,
+			DigitCount: 2,
+			Policy:     &Policy{MinLength: 12, MinLower: 1, MinDigits: 1},
+		},
+	},
+	PresetAWSIAM: {
+		Description: "AWS IAM password policy: 8 to 128 characters from 3 of the 4 character classes",
+		Options: Options{
+			WordCount:   3,
+			CapRule:     CapRuleFirstLetter,
+			DigitPos:    DigitPosEnd,
+			DigitCount:  2,
+			SymbRule:    SymbRuleRandom,
+			SymbPos:     SymbPosEnd,
+			SymbolCount: 1,
+			SymbolPool:  "!@#$%^&*()_+-=[]{}|'",
+			Policy:      &Policy{MinLength: 8, MaxLength: 128, MinClasses: 3},
+		},
+	},
+	PresetWPA2: {
+		Description: "Wi-Fi WPA2 passphrase: 8 to 63 printable ASCII characters",
+		Options: Options{
+			WordCount:    5,
+			MaxLength:    63,
+			StripAccents: true,
+			Policy:       &Policy{MinLength: 8, MaxLength: 63},
+		},
+	},
+	PresetPIN: {
+		Description: "PIN-friendly numeric code: 8 digits typed as 2 blocks of 4, no digit repeated 3 times in a row",
+		Options: Options{
+			Mode:          ModeNumeric,
+			WordCount:     2,
+			MinWordLength: 4,
+			MaxWordLength: 4,
+			SepRule:       SepRuleNone,
+			Policy:        &Policy{MinLength: 8, MaxLength: 8, MinDigits: 8, MaxRepeat: 2},
+		},
+	},
+}
+
+// List the built-in presets, sorted by name. An error is returned if the options of a preset are invalid
+func Presets() ([]Preset, error) {
+	list := make([]Preset, 0, len(presets))
+
+	for name := range presets {
+		p, err := LoadPreset(name)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, Preset{Name: name, Description: presets[name].Description, Options: *p})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list, nil
+}
+
+// Return a copy of the validated options of a built-in preset. See `Presets` for the available names
+func LoadPreset(name string) (*Options, error) {
+	p, exists := presets[name]
+	if !exists {
+		return nil, errors.New("Unknown preset: " + name)
+	}
+
+	opt := p.Options
+	if p.Options.Policy != nil {
+		policy := *p.Options.Policy
+		policy.BannedSubstrings = append([]string(nil), policy.BannedSubstrings...)
+		opt.Policy = &policy
+	}
+
+	if gen := NewGenerator(&opt); gen.err != nil {
+		return nil, errors.New("Invalid preset " + name + ": " + gen.err.Error())
+	}
+
+	return &opt, nil
+}
@@ -0,0 +1,91 @@
+package mempass
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	mrand "math/rand"
+	"sync"
+)
+
+// Source of randomness used by the generator
+type Random interface {
+	Intn(n int) int   // Return a uniformly distributed random integer in [0, n). Must panic if n <= 0
+	Float32() float32 // Return a uniformly distributed random float in [0, 1)
+}
+
+type secureRandom struct{}
+
+// Return a random source backed by `crypto/rand`. This is the default source
+func NewSecureRandom() Random {
+	return secureRandom{}
+}
+
+func (secureRandom) Intn(n int) int {
+	return randInt(n)
+}
+
+func (secureRandom) Float32() float32 {
+	return randFloat32()
+}
+
+type seededRandom struct {
+	mu  sync.Mutex
+	rnd *mrand.Rand
+}
+
+// Return a deterministic random source. The same seed always produces the same passwords.
+// It is NOT suitable for generating real passwords, only for reproducible tests
+func NewSeededRandom(seed int64) Random {
+	return &seededRandom{rnd: mrand.New(mrand.NewSource(seed))}
+}
+
+func (s *seededRandom) Intn(n int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rnd.Intn(n)
+}
+
+func (s *seededRandom) Float32() float32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rnd.Float32()
+}
+
+// Return a random 64 bits integer read from `crypto/rand`
+func randUint64() uint64 {
+	var b [8]byte
+
+	if _, err := rand.Read(b[:]); err != nil {
+		panic("mempass: cannot read from crypto/rand: " + err.Error())
+	}
+
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+// Return a uniformly distributed random integer in [0, n)
+// Values that would introduce a modulo bias are rejected and drawn again
+func randInt(n int) int {
+	if n <= 0 {
+		panic("mempass: invalid argument to randInt")
+	}
+
+	max := uint64(n)
+	// 2^64 % max, computed without overflowing
+	bound := -max % max
+
+	for {
+		v := randUint64()
+
+		if v >= bound {
+			return int(v % max)
+		}
+	}
+}
+
+// Return a uniformly distributed random float in [0, 1)
+func randFloat32() float32 {
+	// A float32 has 24 bits of mantissa
+	return float32(randUint64()>>40) / (1 << 24)
+}
@@ -0,0 +1,255 @@
+package server
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/busyapi/mempass"
+)
+
+// JSON form of `mempass.Options`. Zero values keep the value of the preset, or the default value.
+// Characters are strings of a single character
+type Options struct {
+	Mode            string  `json:"mode,omitempty"`
+	Passphrase      string  `json:"passphrase,omitempty"`
+	Pattern         string  `json:"pattern,omitempty"`
+	Phrase          string  `json:"phrase,omitempty"`
+	WordCount       uint    `json:"word_count,omitempty"`
+	MinWordLength   uint    `json:"min_word_length,omitempty"`
+	MaxWordLength   uint    `json:"max_word_length,omitempty"`
+	DigitsAfter     uint    `json:"digits_after,omitempty"`
+	DigitsBefore    uint    `json:"digits_before,omitempty"`
+	DigitPos        string  `json:"digit_pos,omitempty"`
+	DigitCount      uint    `json:"digit_count,omitempty"`
+	CapRule         string  `json:"cap_rule,omitempty"`
+	CapRatio        float32 `json:"cap_ratio,omitempty"`
+	SymbRule        string  `json:"symb_rule,omitempty"`
+	SymbolsAfter    uint    `json:"symbols_after,omitempty"`
+	SymbolsBefore   uint    `json:"symbols_before,omitempty"`
+	SymbPos         string  `json:"symb_pos,omitempty"`
+	SymbolCount     uint    `json:"symbol_count,omitempty"`
+	SymbolPool      string  `json:"symbol_pool,omitempty"`
+	Symbol          string  `json:"symbol,omitempty"`
+	SepRule         string  `json:"sep_rule,omitempty"`
+	SeparatorPool   string  `json:"separator_pool,omitempty"`
+	Separator       string  `json:"separator,omitempty"`
+	PadRule         string  `json:"pad_rule,omitempty"`
+	PadSymbol       string  `json:"pad_symbol,omitempty"`
+	PadLength       uint    `json:"pad_length,omitempty"`
+	MaxLength       uint    `json:"max_length,omitempty"`
+	L33tRatio       float32 `json:"l33t_ratio,omitempty"`
+	L33tProfile     string  `json:"l33t_profile,omitempty"`
+	MinEntropy      float64 `json:"min_entropy,omitempty"`
+	Language        string  `json:"language,omitempty"`
+	StripAccents    bool    `json:"strip_accents,omitempty"`
+	MinEditDistance uint    `json:"min_edit_distance,omitempty"`
+	Policy          *Policy `json:"policy,omitempty"`
+	PolicyFix       string  `json:"policy_fix,omitempty"`
+}
+
+// JSON form of `mempass.Policy`
+type Policy struct {
+	MinLength        uint     `json:"min_length,omitempty"`
+	MaxLength        uint     `json:"max_length,omitempty"`
+	MinLower         uint     `json:"min_lower,omitempty"`
+	MinUpper         uint     `json:"min_upper,omitempty"`
+	MinDigits        uint     `json:"min_digits,omitempty"`
+	MinSymbols       uint     `json:"min_symbols,omitempty"`
+	MinClasses       uint     `json:"min_classes,omitempty"`
+	ForbiddenChars   string   `json:"forbidden_chars,omitempty"`
+	MaxRepeat        uint     `json:"max_repeat,omitempty"`
+	BannedSubstrings []string `json:"banned_substrings,omitempty"`
+}
+
+// JSON names of the fields of `mempass.Options`, e.g. `Policy.MinLength` is `policy.min_length`
+var jsonNames = func() map[string]string {
+	names := make(map[string]string)
+
+	for _, t := range []struct {
+		prefix, jsonPrefix string
+		typ                reflect.Type
+	}{{"", "", reflect.TypeOf(Options{})}, {"Policy.", "policy.", reflect.TypeOf(Policy{})}} {
+		for i := 0; i < t.typ.NumField(); i++ {
+			f := t.typ.Field(i)
+			tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			names[t.prefix+f.Name] = t.jsonPrefix + tag
+		}
+	}
+
+	return names
+}()
+
+// Option names quoted in the error messages
+var quotedName = regexp.MustCompile("`[A-Za-z0-9.]+`")
+
+// Name of an option in the JSON requests. Options that cannot be set by a request keep their Go name
+func jsonName(field string) string {
+	if name, exists := jsonNames[field]; exists {
+		return name
+	}
+
+	return field
+}
+
+// Error message of an invalid option, using the JSON names of the options
+func optionMessage(err *mempass.OptionError) string {
+	return quotedName.ReplaceAllStringFunc(err.Error(), func(quoted string) string {
+		return "`" + jsonName(strings.Trim(quoted, "`")) + "`"
+	})
+}
+
+// Maximum value of the counts and lengths of the options, so a request cannot ask for huge passwords
+const maxOptionValue = 256
+
+// Set the options that are not zero on `opt`
+func (o *Options) apply(opt *mempass.Options) error {
+	for _, f := range []struct {
+		field string
+		value uint
+	}{
+		{"WordCount", o.WordCount}, {"DigitsAfter", o.DigitsAfter}, {"DigitsBefore", o.DigitsBefore}, {"DigitCount", o.DigitCount},
+		{"SymbolsAfter", o.SymbolsAfter}, {"SymbolsBefore", o.SymbolsBefore}, {"SymbolCount", o.SymbolCount},
+		{"PadLength", o.PadLength}, {"MaxLength", o.MaxLength}, {"MinEditDistance", o.MinEditDistance},
+	} {
+		if f.value > maxOptionValue {
+			return &mempass.OptionError{Field: f.field, Value: f.value, Err: mempass.ErrOutOfRange, Reason: "cannot be greater than " + strconv.Itoa(maxOptionValue)}
+		}
+	}
+
+	if p := o.Policy; p != nil {
+		for _, f := range []struct {
+			field string
+			value uint
+		}{
+			{"Policy.MinLength", p.MinLength}, {"Policy.MaxLength", p.MaxLength}, {"Policy.MinLower", p.MinLower}, {"Policy.MinUpper", p.MinUpper},
+			{"Policy.MinDigits", p.MinDigits}, {"Policy.MinSymbols", p.MinSymbols}, {"Policy.MaxRepeat", p.MaxRepeat},
+		} {
+			if f.value > maxOptionValue {
+				return &mempass.OptionError{Field: f.field, Value: f.value, Err: mempass.ErrOutOfRange, Reason: "cannot be greater than " + strconv.Itoa(maxOptionValue)}
+			}
+		}
+
+		if len(p.BannedSubstrings) > maxOptionValue {
+			return &mempass.OptionError{Field: "Policy.BannedSubstrings", Value: len(p.BannedSubstrings), Err: mempass.ErrOutOfRange, Reason: "cannot have more than " + strconv.Itoa(maxOptionValue) + " substrings"}
+		}
+	}
+
+	if o.MinEntropy > maxOptionValue*8 {
+		return &mempass.OptionError{Field: "MinEntropy", Value: o.MinEntropy, Err: mempass.ErrOutOfRange, Reason: "cannot be greater than " + strconv.Itoa(maxOptionValue*8)}
+	}
+
+	setString(&opt.Passphrase, o.Passphrase)
+	setString(&opt.Pattern, o.Pattern)
+	setString((*string)(&opt.Phrase), o.Phrase)
+	setString((*string)(&opt.Mode), o.Mode)
+	setUint(&opt.WordCount, o.WordCount)
+	setUint(&opt.MinWordLength, o.MinWordLength)
+	setUint(&opt.MaxWordLength, o.MaxWordLength)
+	setUint(&opt.DigitsAfter, o.DigitsAfter)
+	setUint(&opt.DigitsBefore, o.DigitsBefore)
+	setString((*string)(&opt.DigitPos), o.DigitPos)
+	setUint(&opt.DigitCount, o.DigitCount)
+	setString((*string)(&opt.CapRule), o.CapRule)
+	setString((*string)(&opt.SymbRule), o.SymbRule)
+	setUint(&opt.SymbolsAfter, o.SymbolsAfter)
+	setUint(&opt.SymbolsBefore, o.SymbolsBefore)
+	setString((*string)(&opt.SymbPos), o.SymbPos)
+	setUint(&opt.SymbolCount, o.SymbolCount)
+	setString(&opt.SymbolPool, o.SymbolPool)
+	setString((*string)(&opt.SepRule), o.SepRule)
+	setString(&opt.SeparatorPool, o.SeparatorPool)
+	setString((*string)(&opt.PadRule), o.PadRule)
+	setUint(&opt.PadLength, o.PadLength)
+	setUint(&opt.MaxLength, o.MaxLength)
+	setUint(&opt.MinEditDistance, o.MinEditDistance)
+	setString((*string)(&opt.Language), o.Language)
+	setString((*string)(&opt.L33tProfile), o.L33tProfile)
+	setString((*string)(&opt.PolicyFix), o.PolicyFix)
+
+	if o.CapRatio != 0 {
+		opt.CapRatio = o.CapRatio
+	}
+
+	if o.L33tRatio != 0 {
+		opt.L33tRatio = o.L33tRatio
+	}
+
+	if o.MinEntropy != 0 {
+		opt.MinEntropy = o.MinEntropy
+	}
+
+	if o.StripAccents {
+		opt.StripAccents = true
+	}
+
+	for _, r := range []struct {
+		field string
+		value string
+		dst   *rune
+	}{{"Symbol", o.Symbol, &opt.Symbol}, {"Separator", o.Separator, &opt.Separator}, {"PadSymbol", o.PadSymbol, &opt.PadSymbol}} {
+		if r.value == "" {
+			continue
+		}
+
+		if utf8.RuneCountInString(r.value) != 1 {
+			return &mempass.OptionError{Field: r.field, Value: r.value, Err: mempass.ErrUnsupportedValue, Reason: "must be a single character"}
+		}
+
+		*r.dst, _ = utf8.DecodeRuneInString(r.value)
+	}
+
+	if o.Policy != nil {
+		opt.Policy = o.Policy.toPolicy()
+	}
+
+	return nil
+}
+
+func (p *Policy) toPolicy() *mempass.Policy {
+	return &mempass.Policy{
+		MinLength:        p.MinLength,
+		MaxLength:        p.MaxLength,
+		MinLower:         p.MinLower,
+		MinUpper:         p.MinUpper,
+		MinDigits:        p.MinDigits,
+		MinSymbols:       p.MinSymbols,
+		MinClasses:       p.MinClasses,
+		ForbiddenChars:   p.ForbiddenChars,
+		MaxRepeat:        p.MaxRepeat,
+		BannedSubstrings: p.BannedSubstrings,
+	}
+}
+
+func fromPolicy(p *mempass.Policy) *Policy {
+	if p == nil {
+		return nil
+	}
+
+	return &Policy{
+		MinLength:        p.MinLength,
+		MaxLength:        p.MaxLength,
+		MinLower:         p.MinLower,
+		MinUpper:         p.MinUpper,
+		MinDigits:        p.MinDigits,
+		MinSymbols:       p.MinSymbols,
+		MinClasses:       p.MinClasses,
+		ForbiddenChars:   p.ForbiddenChars,
+		MaxRepeat:        p.MaxRepeat,
+		BannedSubstrings: p.BannedSubstrings,
+	}
+}
+
+func setString(dst *string, value string) {
+	if value != "" {
+		*dst = value
+	}
+}
+
+func setUint(dst *uint, value uint) {
+	if value != 0 {
+		*dst = value
+	}
+}
@@ -0,0 +1,446 @@
+// Package server serves the password generator over HTTP, with JSON requests and responses.
+//
+// Endpoints:
+//
+//	GET  /health    Health check
+//	GET  /presets   List the presets
+//	POST /generate  Generate one or more passwords
+//	POST /validate  Check a password against a policy
+//	POST /strength  Estimate how hard a password is to guess
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/busyapi/mempass"
+)
+
+// Server limits. Zero values mean default values
+type Config struct {
+	MaxCount     int   // Maximum number of passwords generated by a request. Default is 100
+	MaxBodyBytes int64 // Maximum size of a request body. Default is 64 KiB
+	MaxInFlight  int   // Maximum number of requests served at the same time, others get a 503 error. Default is 64
+
+	// Maximum time spent generating the passwords of a request, a 503 error is returned after it. Default is 5 seconds
+	GenerateTimeout time.Duration
+}
+
+// Error codes of the JSON errors
+const (
+	CodeNotFound          = "not_found"
+	CodeMethodNotAllowed  = "method_not_allowed"
+	CodeInvalidJSON       = "invalid_json"
+	CodeBodyTooLarge      = "body_too_large"
+	CodeInvalidRequest    = "invalid_request"
+	CodeInvalidOptions    = "invalid_options"
+	CodePolicyUnreachable = "policy_unreachable"
+	CodeUnavailable       = "unavailable"
+	CodeTimeout           = "timeout"
+	CodeInternal          = "internal"
+)
+
+// Body of all error responses
+type ErrorResponse struct {
+	Error Error `json:"error"`
+}
+
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Field   string `json:"field,omitempty"` // Option that caused the error, e.g. `policy.min_length`
+}
+
+type GenerateRequest struct {
+	Preset  string   `json:"preset,omitempty"`  // Preset to start from
+	Options *Options `json:"options,omitempty"` // Options set on top of the preset
+	Count   int      `json:"count,omitempty"`   // Number of distinct passwords. Default is 1
+	Report  bool     `json:"report,omitempty"`  // Add the entropy report of each password
+}
+
+type GenerateResponse struct {
+	Passwords []Password `json:"passwords"`
+}
+
+type Password struct {
+	Password string                 `json:"password"`
+	Entropy  float64                `json:"entropy"`
+	Report   *mempass.EntropyReport `json:"report,omitempty"`
+}
+
+type Preset struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Policy      *Policy `json:"policy,omitempty"`
+}
+
+type PresetsResponse struct {
+	Presets []Preset `json:"presets"`
+}
+
+type ValidateRequest struct {
+	Password string  `json:"password"`
+	Preset   string  `json:"preset,omitempty"` // Check the policy of this preset
+	Policy   *Policy `json:"policy,omitempty"` // Check this policy. Ignored if `Preset` is set
+}
+
+type ValidateResponse struct {
+	Valid      bool        `json:"valid"`
+	Violations []Violation `json:"violations"`
+}
+
+type Violation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// Maximum length of the passwords analyzed by `/strength`, in bytes
+const maxStrengthLength = 1024
+
+type StrengthRequest struct {
+	Password string `json:"password"`
+}
+
+// Guessability of the password, see `mempass.Analyze`
+type StrengthResponse = mempass.Strength
+
+// Rule names of the policy errors
+var rules = []struct {
+	err  error
+	name string
+}{
+	{mempass.ErrTooShort, "min_length"},
+	{mempass.ErrTooLong, "max_length"},
+	{mempass.ErrMissingLower, "min_lower"},
+	{mempass.ErrMissingUpper, "min_upper"},
+	{mempass.ErrMissingDigit, "min_digits"},
+	{mempass.ErrMissingSymbol, "min_symbols"},
+	{mempass.ErrMissingClasses, "min_classes"},
+	{mempass.ErrForbiddenChar, "forbidden_chars"},
+	{mempass.ErrRepeatedRun, "max_repeat"},
+	{mempass.ErrBannedSubstring, "banned_substrings"},
+}
+
+type server struct {
+	cfg      Config
+	inFlight chan struct{}
+}
+
+// Return the HTTP handler of the API
+func New(cfg Config) http.Handler {
+	if cfg.MaxCount <= 0 {
+		cfg.MaxCount = 100
+	}
+
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = 64 << 10
+	}
+
+	if cfg.MaxInFlight <= 0 {
+		cfg.MaxInFlight = 64
+	}
+
+	if cfg.GenerateTimeout <= 0 {
+		cfg.GenerateTimeout = 5 * time.Second
+	}
+
+	s := &server{cfg: cfg, inFlight: make(chan struct{}, cfg.MaxInFlight)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", s.method(http.MethodGet, s.health))
+	mux.HandleFunc("/presets", s.method(http.MethodGet, s.presets))
+	mux.HandleFunc("/generate", s.method(http.MethodPost, s.generate))
+	mux.HandleFunc("/validate", s.method(http.MethodPost, s.validate))
+	mux.HandleFunc("/strength", s.method(http.MethodPost, s.strength))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, CodeNotFound, "Unknown endpoint: "+r.URL.Path)
+	})
+
+	return s.limit(mux)
+}
+
+// Reject the requests above `MaxInFlight` and the bodies above `MaxBodyBytes`
+func (s *server) limit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case s.inFlight <- struct{}{}:
+		default:
+			writeError(w, http.StatusServiceUnavailable, CodeUnavailable, "Too many requests in flight")
+			return
+		}
+
+		sl := &slot{inFlight: s.inFlight}
+		defer func() {
+			if !sl.kept {
+				<-s.inFlight
+			}
+		}()
+
+		r = r.WithContext(context.WithValue(r.Context(), slotKey{}, sl))
+		r.Body = http.MaxBytesReader(w, r.Body, s.cfg.MaxBodyBytes)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Slot of `MaxInFlight` taken by a request
+type slot struct {
+	inFlight chan struct{}
+	kept     bool // The slot is freed by work going on after the handler returns
+}
+
+type slotKey struct{}
+
+// Keep the slot of a request taken after the handler returns, until the returned function is called.
+// It must be called by the handler, before it returns
+func keepSlot(r *http.Request) func() {
+	sl, exists := r.Context().Value(slotKey{}).(*slot)
+	if !exists {
+		return func() {}
+	}
+
+	sl.kept = true
+
+	return func() { <-sl.inFlight }
+}
+
+// Only accept requests using `method`
+func (s *server) method(method string, fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			writeError(w, http.StatusMethodNotAllowed, CodeMethodNotAllowed, "Use "+method)
+			return
+		}
+
+		fn(w, r)
+	}
+}
+
+func (s *server) health(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *server) presets(w http.ResponseWriter, r *http.Request) {
+	list, err := mempass.Presets()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, CodeInternal, err.Error())
+		return
+	}
+
+	res := PresetsResponse{Presets: []Preset{}}
+
+	for _, p := range list {
+		res.Presets = append(res.Presets, Preset{Name: p.Name, Description: p.Description, Policy: fromPolicy(p.Options.Policy)})
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *server) generate(w http.ResponseWriter, r *http.Request) {
+	var req GenerateRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	if req.Count == 0 {
+		req.Count = 1
+	}
+
+	if req.Count < 0 || req.Count > s.cfg.MaxCount {
+		writeError(w, http.StatusBadRequest, CodeInvalidRequest, "`count` must be between 1 and "+strconv.Itoa(s.cfg.MaxCount))
+		return
+	}
+
+	opt := &mempass.Options{}
+
+	if req.Preset != "" {
+		var err error
+		if opt, err = mempass.LoadPreset(req.Preset); err != nil {
+			writeError(w, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+	}
+
+	if req.Options != nil {
+		if err := req.Options.apply(opt); err != nil {
+			writeOptionError(w, err)
+			return
+		}
+	}
+
+	res := GenerateResponse{Passwords: make([]Password, 0, req.Count)}
+	gen := mempass.NewGenerator(opt)
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.cfg.GenerateTimeout)
+	defer cancel()
+
+	// The generation cannot be interrupted, it ends in the background after a timeout.
+	// It keeps its slot until then, so `MaxInFlight` also limits the abandoned generations
+	type result struct {
+		pwds    []string
+		reports []*mempass.EntropyReport
+		err     error
+	}
+
+	done := make(chan result, 1)
+	release := keepSlot(r)
+
+	go func() {
+		defer release()
+
+		pwds, reports, err := gen.GenPasswordsReport(req.Count)
+		done <- result{pwds, reports, err}
+	}()
+
+	var pwds []string
+	var reports []*mempass.EntropyReport
+	var err error
+
+	select {
+	case <-ctx.Done():
+		writeError(w, http.StatusServiceUnavailable, CodeTimeout, "Passwords not generated within "+s.cfg.GenerateTimeout.String())
+		return
+	case out := <-done:
+		pwds, reports, err = out.pwds, out.reports, out.err
+	}
+
+	if errors.Is(err, mempass.ErrPolicyUnreachable) {
+		writeError(w, http.StatusUnprocessableEntity, CodePolicyUnreachable, err.Error())
+		return
+	} else if err != nil {
+		writeOptionError(w, err)
+		return
+	}
+
+	for i, pwd := range pwds {
+		p := Password{Password: pwd, Entropy: reports[i].Bits}
+		if req.Report {
+			p.Report = reports[i]
+		}
+
+		res.Passwords = append(res.Passwords, p)
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *server) validate(w http.ResponseWriter, r *http.Request) {
+	var req ValidateRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	var policy *mempass.Policy
+
+	if req.Preset != "" {
+		opt, err := mempass.LoadPreset(req.Preset)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+
+		policy = opt.Policy
+	} else if req.Policy != nil {
+		policy = req.Policy.toPolicy()
+	} else {
+		writeError(w, http.StatusBadRequest, CodeInvalidRequest, "`preset` or `policy` is required")
+		return
+	}
+
+	res := ValidateResponse{Valid: true, Violations: []Violation{}}
+
+	var policyErr *mempass.PolicyError
+	if errors.As(policy.Check(req.Password), &policyErr) {
+		res.Valid = false
+
+		for _, v := range policyErr.Violations {
+			res.Violations = append(res.Violations, Violation{Rule: ruleName(v.Err), Message: v.Error()})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *server) strength(w http.ResponseWriter, r *http.Request) {
+	var req StrengthRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	if len(req.Password) > maxStrengthLength {
+		writeError(w, http.StatusBadRequest, CodeInvalidRequest, "`password` cannot be longer than "+strconv.Itoa(maxStrengthLength)+" bytes")
+		return
+	}
+
+	res, err := mempass.Analyze(req.Password)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, CodeInternal, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func ruleName(err error) string {
+	for _, r := range rules {
+		if errors.Is(err, r.err) {
+			return r.name
+		}
+	}
+
+	return "unknown"
+}
+
+// Decode the JSON body of a request. An error response is written if it fails
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(v); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, CodeBodyTooLarge, "Request body is larger than "+strconv.Itoa(int(tooLarge.Limit))+" bytes")
+		} else {
+			writeError(w, http.StatusBadRequest, CodeInvalidJSON, "Invalid JSON body: "+err.Error())
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// Write a JSON response. The response is encoded before anything is written, so an encoding error is a 500 error
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		buf.Reset()
+		json.NewEncoder(&buf).Encode(ErrorResponse{Error: Error{Code: CodeInternal, Message: "Cannot encode the response: " + err.Error()}})
+		status = http.StatusInternalServerError
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+func writeError(w http.ResponseWriter, status int, code, msg string) {
+	writeJSON(w, status, ErrorResponse{Error: Error{Code: code, Message: msg}})
+}
+
+// Write an `invalid_options` error, with the invalid option if it is known
+func writeOptionError(w http.ResponseWriter, err error) {
+	var optErr *mempass.OptionError
+	if !errors.As(err, &optErr) {
+		writeError(w, http.StatusBadRequest, CodeInvalidOptions, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: Error{Code: CodeInvalidOptions, Message: optionMessage(optErr), Field: jsonName(optErr.Field)}})
+}
@@ -0,0 +1,218 @@
+package server
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHealth(t *testing.T) {
+	var res map[string]string
+	testRequest(t, http.MethodGet, "/health", "", http.StatusOK, &res)
+
+	if res["status"] != "ok" {
+		t.Errorf("got %v", res)
+	}
+}
+
+func TestPresets(t *testing.T) {
+	var res PresetsResponse
+	testRequest(t, http.MethodGet, "/presets", "", http.StatusOK, &res)
+
+	if len(res.Presets) != 6 || res.Presets[0].Name != "ad-complexity" || res.Presets[0].Policy.MinClasses != 3 {
+		t.Errorf("got %+v", res)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	var res GenerateResponse
+	testRequest(t, http.MethodPost, "/generate", `{"count": 5, "report": true, "options": {"word_count": 2, "separator": "_", "digit_pos": "end"}}`, http.StatusOK, &res)
+
+	if len(res.Passwords) != 5 {
+		t.Fatalf("got %+v", res)
+	}
+
+	for _, p := range res.Passwords {
+		if !strings.Contains(p.Password, "_") || p.Entropy <= 0 || p.Report == nil || p.Report.Bits != p.Entropy {
+			t.Errorf("got %+v", p)
+		}
+	}
+
+	res = GenerateResponse{}
+	testRequest(t, http.MethodPost, "/generate", `{"preset": "pin"}`, http.StatusOK, &res)
+
+	if len(res.Passwords) != 1 || len(res.Passwords[0].Password) != 8 || res.Passwords[0].Report != nil {
+		t.Errorf("got %+v", res)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var res ValidateResponse
+	testRequest(t, http.MethodPost, "/validate", `{"password": "Tiny-Horse-42", "preset": "ad-complexity"}`, http.StatusOK, &res)
+
+	if !res.Valid || len(res.Violations) != 0 {
+		t.Errorf("got %+v", res)
+	}
+
+	testRequest(t, http.MethodPost, "/validate", `{"password": "aaab", "policy": {"min_length": 8, "max_repeat": 2}}`, http.StatusOK, &res)
+
+	if res.Valid || len(res.Violations) != 2 || res.Violations[0].Rule != "min_length" || res.Violations[1].Rule != "max_repeat" {
+		t.Errorf("got %+v", res)
+	}
+}
+
+func TestStrength(t *testing.T) {
+	var res StrengthResponse
+	testRequest(t, http.MethodPost, "/strength", `{"password": "P4ssw0rd"}`, http.StatusOK, &res)
+
+	if res.Score != 0 || len(res.Matches) != 1 || res.Matches[0].Word != "password" || res.Feedback.Warning == "" {
+		t.Errorf("got %+v", res)
+	}
+
+	res = StrengthResponse{}
+	testRequest(t, http.MethodPost, "/strength", `{"password": "correcthorsebatterystaple"}`, http.StatusOK, &res)
+
+	if res.Score != 4 || res.CrackTimes.OfflineSlowHash <= 0 {
+		t.Errorf("got %+v", res)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, c := range []struct {
+		method, path, body string
+		status             int
+		code               string
+	}{
+		{http.MethodGet, "/unknown", "", http.StatusNotFound, CodeNotFound},
+		{http.MethodGet, "/generate", "", http.StatusMethodNotAllowed, CodeMethodNotAllowed},
+		{http.MethodPost, "/generate", `{"count":`, http.StatusBadRequest, CodeInvalidJSON},
+		{http.MethodPost, "/generate", `{"unknown": 1}`, http.StatusBadRequest, CodeInvalidJSON},
+		{http.MethodPost, "/generate", `{"count": 1000}`, http.StatusBadRequest, CodeInvalidRequest},
+		{http.MethodPost, "/generate", `{"preset": "unknown"}`, http.StatusBadRequest, CodeInvalidRequest},
+		{http.MethodPost, "/generate", `{"options": {"separator": "ab"}}`, http.StatusBadRequest, CodeInvalidOptions},
+		{http.MethodPost, "/generate", `{"options": {"word_count": 100000}}`, http.StatusBadRequest, CodeInvalidOptions},
+		{http.MethodPost, "/generate", `{"options": {"policy": {"min_digits": 200000}, "policy_fix": "repair"}}`, http.StatusBadRequest, CodeInvalidOptions},
+		{http.MethodPost, "/generate", `{"options": {"min_word_length": 9, "max_word_length": 8}}`, http.StatusBadRequest, CodeInvalidOptions},
+		{http.MethodPost, "/generate", `{"options": {"policy": {"forbidden_chars": "-"}}}`, http.StatusUnprocessableEntity, CodePolicyUnreachable},
+		{http.MethodPost, "/generate", `{"options": {"passphrase": "` + strings.Repeat("a", 100<<10) + `"}}`, http.StatusRequestEntityTooLarge, CodeBodyTooLarge},
+		{http.MethodPost, "/validate", `{"password": "a"}`, http.StatusBadRequest, CodeInvalidRequest},
+		{http.MethodPost, "/strength", `{"password": "` + strings.Repeat("a", 2000) + `"}`, http.StatusBadRequest, CodeInvalidRequest},
+	} {
+		var res ErrorResponse
+		testRequest(t, c.method, c.path, c.body, c.status, &res)
+
+		if res.Error.Code != c.code || res.Error.Message == "" {
+			t.Errorf("%s %s: got %+v, want code %s", c.method, c.path, res, c.code)
+		}
+	}
+}
+
+func TestOptionErrorField(t *testing.T) {
+	for _, c := range []struct {
+		body, field, message string
+	}{
+		{`{"options": {"min_word_length": 9, "max_word_length": 8}}`, "min_word_length", "`min_word_length` cannot be greater than `max_word_length`"},
+		{`{"options": {"separator": "ab"}}`, "separator", "`separator` must be a single character"},
+		{`{"options": {"mode": "passphrase"}}`, "passphrase", "`passphrase` is required in passphrase mode"},
+		{`{"options": {"policy": {"min_classes": 5}}}`, "policy.min_classes", "`policy.min_classes` cannot be greater than 4"},
+		{`{"options": {"policy": {"min_digits": 200000}}}`, "policy.min_digits", "`policy.min_digits` cannot be greater than 256"},
+	} {
+		var res ErrorResponse
+		testRequest(t, http.MethodPost, "/generate", c.body, http.StatusBadRequest, &res)
+
+		if res.Error.Code != CodeInvalidOptions || res.Error.Field != c.field || res.Error.Message != c.message {
+			t.Errorf("%s: got %+v", c.body, res.Error)
+		}
+	}
+}
+
+func TestInFlight(t *testing.T) {
+	s := &server{cfg: Config{MaxBodyBytes: 1024}, inFlight: make(chan struct{}, 1)}
+	s.inFlight <- struct{}{}
+
+	w := httptest.NewRecorder()
+	s.limit(http.NotFoundHandler()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("got status %d", w.Code)
+	}
+}
+
+func TestGenerateTimeout(t *testing.T) {
+	w := httptest.NewRecorder()
+	New(Config{GenerateTimeout: time.Nanosecond}).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/generate", strings.NewReader(`{"count": 100}`)))
+
+	var res ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || w.Code != http.StatusServiceUnavailable || res.Error.Code != CodeTimeout {
+		t.Errorf("got status %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestWriteJSONError(t *testing.T) {
+	w := httptest.NewRecorder()
+	writeJSON(w, http.StatusOK, map[string]float64{"guesses": math.Inf(1)})
+
+	var res ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || w.Code != http.StatusInternalServerError || res.Error.Code != CodeInternal {
+		t.Errorf("got status %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestKeepSlot(t *testing.T) {
+	s := &server{cfg: Config{MaxBodyBytes: 1024}, inFlight: make(chan struct{}, 1)}
+	work := make(chan struct{})
+
+	h := s.limit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		release := keepSlot(r)
+		go func() {
+			defer release()
+			<-work
+		}()
+	}))
+
+	status := func() int {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		return w.Code
+	}
+
+	// The slot stays taken while the work goes on after the first handler returned
+	if got := status(); got != http.StatusOK {
+		t.Fatalf("got status %d", got)
+	}
+
+	if got := status(); got != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want the slot still taken", got)
+	}
+
+	// The slot is freed once the work ends
+	close(work)
+
+	select {
+	case s.inFlight <- struct{}{}:
+		<-s.inFlight
+	case <-time.After(time.Second):
+		t.Error("slot not freed once the work ended")
+	}
+}
+
+func testRequest(t *testing.T, method, path, body string, status int, v any) {
+	w := httptest.NewRecorder()
+	New(Config{}).ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+
+	if w.Code != status {
+		t.Errorf("%s %s: got status %d, want %d: %s", method, path, w.Code, status, w.Body.String())
+	}
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("%s %s: got content type %q", method, path, ct)
+	}
+
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Errorf("%s %s: %v", method, path, err)
+	}
+}
@@ -0,0 +1,531 @@
+package mempass
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kind of pattern found in a password by `Analyze`
+type MatchPattern string
+
+const (
+	MatchDictionary MatchPattern = "dictionary" // A dictionary word or a common password, possibly capitalized or 1337 coded
+	MatchKeyboard   MatchPattern = "keyboard"   // Adjacent keys of a QWERTY keyboard, e.g. `qwerty` or `zxcvb`
+	MatchRepeat     MatchPattern = "repeat"     // A repeated character or string, e.g. `aaa` or `abcabc`
+	MatchSequence   MatchPattern = "sequence"   // Characters following each other, e.g. `abcd` or `9753`
+	MatchDate       MatchPattern = "date"       // A date or a year, e.g. `1987` or `13/05/1987`
+	MatchBruteforce MatchPattern = "bruteforce" // Characters that don't match any other pattern
+)
+
+// Only the first characters are matched against patterns, the others are counted as bruteforce
+const maxAnalyzeLength = 100
+
+// Guesses are capped to 10^maxGuessesLog10, so they stay finite for long passwords
+const maxGuessesLog10 = 300
+
+// Guesses of the attack models, per second
+const (
+	onlineThrottledRate   = 100.0 / 3600
+	onlineUnthrottledRate = 10.0
+	offlineSlowHashRate   = 1e4
+	offlineFastHashRate   = 1e10
+)
+
+// Minimum guesses of a part of a password, so that a password is never split in many small but cheap parts
+const (
+	minSubmatchGuessesSingleChar = 10
+	minSubmatchGuessesMultiChar  = 50
+)
+
+// Cost of each additional part of a password, as the attacker doesn't know how many parts there are
+const partPenalty = 10000
+
+// A part of a password matching a pattern
+type Match struct {
+	Pattern MatchPattern `json:"pattern"`
+	Token   string       `json:"token"`          // Part of the password that matches
+	Start   int          `json:"start"`          // Position of the first character of the token, in characters
+	End     int          `json:"end"`            // Position after the last character of the token
+	Word    string       `json:"word,omitempty"` // Dictionary word or common password, for dictionary matches
+	L33t    bool         `json:"l33t,omitempty"` // The word is 1337 coded
+	Guesses float64      `json:"guesses"`        // Estimated number of guesses to find the token
+
+	year      int  // Year of a date match
+	separator bool // The date has separators
+	monthDay  bool // The date has a month and a day, not only a year
+}
+
+// Time to crack a password, in seconds, with several attack models
+type CrackTimes struct {
+	OnlineThrottled   float64 `json:"online_throttled"`   // Online attack of a service limiting the attempts, 100 per hour
+	OnlineUnthrottled float64 `json:"online_unthrottled"` // Online attack of a service that doesn't limit the attempts, 10 per second
+	OfflineSlowHash   float64 `json:"offline_slow_hash"`  // Offline attack of a slow hash such as bcrypt, 10k per second
+	OfflineFastHash   float64 `json:"offline_fast_hash"`  // Offline attack of a fast hash such as SHA-256, 10 billion per second
+}
+
+// Advice to the user who chose the password
+type Feedback struct {
+	Warning     string   `json:"warning"`     // What makes the password weak. Empty if the password is strong enough
+	Suggestions []string `json:"suggestions"` // How to choose a stronger password
+}
+
+// Guessability of a password
+type Strength struct {
+	Score        int        `json:"score"`         // 0 = too guessable, 1 = very guessable, 2 = somewhat guessable, 3 = safely unguessable, 4 = very unguessable
+	Guesses      float64    `json:"guesses"`       // Estimated number of guesses to find the password, 1e300 at most
+	GuessesLog10 float64    `json:"guesses_log10"` // Base 10 logarithm of `Guesses`
+	CrackTimes   CrackTimes `json:"crack_times"`
+	Matches      []*Match   `json:"matches"` // Parts of the password, in order, that make the cheapest way of guessing it
+	Feedback     Feedback   `json:"feedback"`
+}
+
+// Estimate how many guesses an attacker needs to find any password, including passwords not generated by this package.
+// The password is split in the parts that are the cheapest to guess: dictionary words, even capitalized or 1337 coded,
+// keyboard walks, repeats, sequences and dates. Words are searched in `dicts`, the embedded English dictionary by default,
+// and in a list of common passwords. An error is returned if the English dictionary cannot be loaded
+func Analyze(password string, dicts ...*Dictionary) (*Strength, error) {
+	if len(dicts) == 0 {
+		dict, err := LoadLanguageDictionary(LangEnglish)
+		if err != nil {
+			return nil, err
+		}
+
+		dicts = []*Dictionary{dict}
+	}
+
+	return analyze(password, dicts), nil
+}
+
+func analyze(password string, dicts []*Dictionary) *Strength {
+	pwd := toRunes(password)
+	analyzed := pwd[:min(len(pwd), maxAnalyzeLength)]
+
+	matches := findMatches(analyzed, dicts)
+	for _, m := range matches {
+		m.Guesses = matchGuesses(m, len(analyzed), dicts)
+	}
+
+	s := &Strength{}
+	s.Guesses, s.Matches = mostGuessableSequence(analyzed, matches)
+
+	if rest := pwd[len(analyzed):]; len(rest) > 0 {
+		m := bruteforceMatch(pwd, len(analyzed), len(pwd))
+		s.Matches = append(s.Matches, m)
+		s.Guesses *= m.Guesses
+	}
+
+	s.Guesses = math.Min(s.Guesses, math.Pow(10, maxGuessesLog10))
+	s.GuessesLog10 = math.Log10(s.Guesses)
+	s.Score = guessesScore(s.Guesses)
+	s.CrackTimes = CrackTimes{
+		OnlineThrottled:   s.Guesses / onlineThrottledRate,
+		OnlineUnthrottled: s.Guesses / onlineUnthrottledRate,
+		OfflineSlowHash:   s.Guesses / offlineSlowHashRate,
+		OfflineFastHash:   s.Guesses / offlineFastHashRate,
+	}
+	s.Feedback = feedback(s.Score, s.Matches)
+
+	return s
+}
+
+// Score of a number of guesses, the thresholds are the ones of zxcvbn
+func guessesScore(guesses float64) int {
+	for score, threshold := range []float64{1e3, 1e6, 1e8, 1e10} {
+		if guesses < threshold+5 {
+			return score
+		}
+	}
+
+	return 4
+}
+
+// One step of the cheapest sequence of matches ending at a position
+type step struct {
+	match   *Match
+	product float64 // Product of the guesses of the matches of the sequence
+	total   float64 // Guesses of the whole sequence
+}
+
+// Find the sequence of non overlapping matches covering the password that is the cheapest to guess.
+// The characters not covered by a match are bruteforced. Each additional match adds a penalty,
+// so a password is not split in many small parts
+func mostGuessableSequence(pwd []rune, matches []*Match) (float64, []*Match) {
+	n := len(pwd)
+	if n == 0 {
+		return 1, []*Match{}
+	}
+
+	// `best[j][k]` is the cheapest sequence of `k` matches covering the `j+1` first characters
+	best := make([]map[int]*step, n)
+	for j := range best {
+		best[j] = make(map[int]*step)
+	}
+
+	update := func(m *Match, k int, product float64) {
+		total := factorial(k)*product + math.Pow(partPenalty, float64(k-1))
+		if s, exists := best[m.End-1][k]; !exists || total < s.total {
+			best[m.End-1][k] = &step{match: m, product: product, total: total}
+		}
+	}
+
+	byEnd := make([][]*Match, n)
+	for _, m := range matches {
+		byEnd[m.End-1] = append(byEnd[m.End-1], m)
+	}
+
+	for j := 0; j < n; j++ {
+		for _, m := range byEnd[j] {
+			if m.Start == 0 {
+				update(m, 1, m.Guesses)
+				continue
+			}
+
+			for k, prev := range best[m.Start-1] {
+				update(m, k+1, prev.product*m.Guesses)
+			}
+		}
+
+		for i := 0; i <= j; i++ {
+			m := bruteforceMatch(pwd, i, j+1)
+			if i == 0 {
+				update(m, 1, m.Guesses)
+				continue
+			}
+
+			for k, prev := range best[i-1] {
+				// Two bruteforce matches in a row are a single bruteforce match
+				if prev.match.Pattern != MatchBruteforce {
+					update(m, k+1, prev.product*m.Guesses)
+				}
+			}
+		}
+	}
+
+	bestK := 0
+	for k, s := range best[n-1] {
+		if bestK == 0 || s.total < best[n-1][bestK].total || (s.total == best[n-1][bestK].total && k < bestK) {
+			bestK = k
+		}
+	}
+
+	guesses := best[n-1][bestK].total
+	sequence := make([]*Match, bestK)
+
+	for j, k := n-1, bestK; k > 0; k-- {
+		sequence[k-1] = best[j][k].match
+		j = sequence[k-1].Start - 1
+	}
+
+	return guesses, sequence
+}
+
+func bruteforceMatch(pwd []rune, start, end int) *Match {
+	m := &Match{Pattern: MatchBruteforce, Token: string(pwd[start:end]), Start: start, End: end}
+	m.Guesses = math.Pow(10, math.Min(float64(end-start), maxGuessesLog10))
+
+	if start > 0 || end < len(pwd) {
+		if end-start == 1 {
+			m.Guesses = math.Max(m.Guesses, minSubmatchGuessesSingleChar+1)
+		} else {
+			m.Guesses = math.Max(m.Guesses, minSubmatchGuessesMultiChar+1)
+		}
+	}
+
+	return m
+}
+
+// Estimate the guesses of a match of a password of `length` characters
+func matchGuesses(m *Match, length int, dicts []*Dictionary) float64 {
+	minGuesses := 1.0
+	if m.End-m.Start < length {
+		minGuesses = minSubmatchGuessesMultiChar
+		if m.End-m.Start == 1 {
+			minGuesses = minSubmatchGuessesSingleChar
+		}
+	}
+
+	var guesses float64
+	token := toRunes(m.Token)
+
+	switch m.Pattern {
+	case MatchDictionary:
+		guesses = wordRank(m.Word, dicts) * uppercaseVariations(token)
+		if m.L33t {
+			guesses *= l33tVariations(token, m.Word)
+		}
+	case MatchKeyboard:
+		guesses = keyboardGuesses(token)
+	case MatchRepeat:
+		guesses = repeatGuesses(token, dicts)
+	case MatchSequence:
+		guesses = sequenceGuesses(token)
+	case MatchDate:
+		guesses = dateGuesses(m)
+	default:
+		guesses = math.Pow(10, float64(len(token)))
+	}
+
+	return math.Max(guesses, minGuesses)
+}
+
+// Number of guesses to find a word: its rank in the common passwords, or the number of words of the dictionary
+// that are not longer, as an attacker tries the shortest words first
+func wordRank(word string, dicts []*Dictionary) float64 {
+	if rank, exists := commonPasswordRanks()[word]; exists {
+		return float64(rank)
+	}
+
+	rank := math.Inf(1)
+	for _, dict := range dicts {
+		if r, exists := dict.ranks()[word]; exists {
+			rank = math.Min(rank, float64(r))
+		}
+	}
+
+	return rank
+}
+
+// Number of ways a word can be capitalized like the token. A capitalized first or last letter,
+// or an all uppercase word, are the most common
+func uppercaseVariations(token []rune) float64 {
+	var upper, lower int
+	for _, char := range token {
+		if unicode.IsUpper(char) {
+			upper++
+		} else if unicode.IsLower(char) {
+			lower++
+		}
+	}
+
+	if upper == 0 {
+		return 1
+	}
+
+	if lower == 0 || (upper == 1 && (unicode.IsUpper(token[0]) || unicode.IsUpper(token[len(token)-1]))) {
+		return 2
+	}
+
+	variations := 0.0
+	for i := 1; i <= min(upper, lower); i++ {
+		variations += binomial(upper+lower, i)
+	}
+
+	return variations
+}
+
+// Number of ways a word can be 1337 coded like the token
+func l33tVariations(token []rune, word string) float64 {
+	plain := toRunes(word)
+	subbed := make(map[rune]int)
+	unsubbed := make(map[rune]int)
+
+	for i, char := range token {
+		if unicode.ToLower(char) == plain[i] {
+			unsubbed[plain[i]]++
+		} else {
+			subbed[plain[i]]++
+		}
+	}
+
+	variations := 1.0
+	for letter, s := range subbed {
+		u := unsubbed[letter]
+		if u == 0 {
+			variations *= 2
+			continue
+		}
+
+		possible := 0.0
+		for i := 1; i <= min(s, u); i++ {
+			possible += binomial(s+u, i)
+		}
+
+		variations *= possible
+	}
+
+	return variations
+}
+
+func repeatGuesses(token []rune, dicts []*Dictionary) float64 {
+	base := repeatBase(token)
+
+	return analyze(string(base), dicts).Guesses * float64(len(token)/len(base))
+}
+
+func sequenceGuesses(token []rune) float64 {
+	base := 26.0
+
+	switch {
+	case strings.ContainsRune("aAzZ019", token[0]):
+		// Obvious starts
+		base = 4
+	case unicode.IsDigit(token[0]):
+		base = 10
+	}
+
+	if token[1] < token[0] {
+		base *= 2
+	}
+
+	return base * float64(len(token))
+}
+
+var (
+	rankOnce sync.Once
+	rankMap  map[string]int
+)
+
+// Rank of the common passwords
+func commonPasswordRanks() map[string]int {
+	rankOnce.Do(func() {
+		rankMap = make(map[string]int, len(commonPasswords))
+		for i, word := range commonPasswords {
+			rankMap[word] = i + 1
+		}
+	})
+
+	return rankMap
+}
+
+// Rank of the words of the dictionary: the number of words that are not longer than each word
+func (d *Dictionary) ranks() map[string]int {
+	d.rankOnce.Do(func() {
+		d.rank = make(map[string]int, len(d.index.words))
+		for l := 1; l < len(d.index.offsets)-1; l++ {
+			for _, word := range d.index.words[d.index.offsets[l]:d.index.offsets[l+1]] {
+				d.rank[word] = d.index.offsets[l+1]
+			}
+		}
+	})
+
+	return d.rank
+}
+
+// Give advice about the weakest part of a password
+func feedback(score int, matches []*Match) Feedback {
+	if len(matches) == 0 {
+		return Feedback{
+			Suggestions: []string{
+				"Use a few words, avoid common phrases",
+				"No need for symbols, digits, or uppercase letters",
+			},
+		}
+	}
+
+	if score > 2 {
+		return Feedback{Suggestions: []string{}}
+	}
+
+	// The longest match is the one that stands out
+	longest := matches[0]
+	for _, m := range matches[1:] {
+		if m.End-m.Start > longest.End-longest.Start {
+			longest = m
+		}
+	}
+
+	f := matchFeedback(longest, len(matches) == 1)
+	f.Suggestions = append([]string{"Add another word or two. Uncommon words are better."}, f.Suggestions...)
+
+	return f
+}
+
+func matchFeedback(m *Match, alone bool) Feedback {
+	switch m.Pattern {
+	case MatchDictionary:
+		return dictionaryFeedback(m, alone)
+
+	case MatchKeyboard:
+		f := Feedback{Suggestions: []string{"Use a longer keyboard pattern with more turns"}}
+		if keyboardTurns(toRunes(m.Token)) == 1 {
+			f.Warning = "Straight rows of keys are easy to guess"
+		} else {
+			f.Warning = "Short keyboard patterns are easy to guess"
+		}
+
+		return f
+
+	case MatchRepeat:
+		f := Feedback{Suggestions: []string{"Avoid repeated words and characters"}}
+		if len(repeatBase(toRunes(m.Token))) == 1 {
+			f.Warning = `Repeats like "aaa" are easy to guess`
+		} else {
+			f.Warning = `Repeats like "abcabcabc" are only slightly harder to guess than "abc"`
+		}
+
+		return f
+
+	case MatchSequence:
+		return Feedback{Warning: "Sequences like abc or 6543 are easy to guess", Suggestions: []string{"Avoid sequences"}}
+
+	case MatchDate:
+		return Feedback{Warning: "Dates are often easy to guess", Suggestions: []string{"Avoid dates and years that are associated with you"}}
+	}
+
+	return Feedback{Suggestions: []string{}}
+}
+
+func dictionaryFeedback(m *Match, alone bool) Feedback {
+	f := Feedback{}
+	_, common := commonPasswordRanks()[m.Word]
+
+	switch {
+	case common && alone:
+		f.Warning = "This is a very common password"
+	case common:
+		f.Warning = "This is similar to a commonly used password"
+	case alone:
+		f.Warning = "A word by itself is easy to guess"
+	}
+
+	switch first, _ := utf8.DecodeRuneInString(m.Token); {
+	case strings.ToUpper(m.Token) == m.Token && strings.ToLower(m.Token) != m.Token:
+		f.Suggestions = append(f.Suggestions, "All-uppercase is almost as easy to guess as all-lowercase")
+	case unicode.IsUpper(first):
+		f.Suggestions = append(f.Suggestions, "Capitalization doesn't help very much")
+	}
+
+	if m.L33t {
+		f.Suggestions = append(f.Suggestions, "Predictable substitutions like '@' instead of 'a' don't help very much")
+	}
+
+	return f
+}
+
+func factorial(n int) float64 {
+	f := 1.0
+	for i := 2; i <= n; i++ {
+		f *= float64(i)
+	}
+
+	return f
+}
+
+// Number of ways to choose `k` elements among `n`
+func binomial(n, k int) float64 {
+	if k < 0 || k > n {
+		return 0
+	}
+
+	r := 1.0
+	for i := 1; i <= k; i++ {
+		r = r * float64(n-k+i) / float64(i)
+	}
+
+	return math.Round(r)
+}
+
+// Sort matches by position, then by length
+func sortMatches(matches []*Match) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Start != matches[j].Start {
+			return matches[i].Start < matches[j].Start
+		}
+
+		return matches[i].End < matches[j].End
+	})
+}
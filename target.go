@@ -0,0 +1,126 @@
+package mempass
+
+import (
+	"math"
+	"strings"
+)
+
+// Maximum number of words that can be added to reach `MinEntropy`
+const maxTargetWords = 64
+
+// Choose the number of words, digits and symbols so that the entropy reaches `MinEntropy`.
+// Words are added first. Digits after each word (2 at most) if `DigitPos` is `DigitPosWord`, then a random symbol after each word
+// if `SymbRule` is `SymbRuleRandom` and `SymbPos` is `SymbPosWord`, are only used when they are enough to close the remaining gap.
+// Digits and symbols set by the caller, even to 0, are never changed
+func (g *Generator) fitEntropy() error {
+	// The words of a phrase are given by its parts of speech, only the other options could add entropy
+	if g.opt.Mode == ModePhrase {
+		if bits, err := g.estimateEntropy(*g.opt); err != nil || bits < g.opt.MinEntropy {
+			return optionError("MinEntropy", g.opt.MinEntropy, ErrConflict, "cannot be reached with `Phrase`, add digits or symbols")
+		}
+
+		return nil
+	}
+
+	opt := *g.opt
+	opt.WordCount = 1
+
+	addDigits := opt.DigitPos == DigitPosWord && opt.DigitsAfter == 0 && opt.unset(fieldDigitCount)
+	addSymbols := opt.SymbRule == SymbRuleRandom && opt.SymbPos == SymbPosWord && opt.SymbolsAfter == 0 && opt.unset(fieldSymbolCount)
+
+	for {
+		bits, err := g.estimateEntropy(opt)
+		if err != nil {
+			return err
+		}
+
+		if bits >= g.opt.MinEntropy {
+			break
+		}
+
+		if addDigits && opt.DigitsAfter < 2 {
+			more := opt
+			more.DigitsAfter = 2
+
+			if bits, err := g.estimateEntropy(more); err == nil && bits >= g.opt.MinEntropy {
+				opt.DigitsAfter++
+				continue
+			}
+		}
+
+		if addSymbols && opt.SymbolsAfter < 1 {
+			more := opt
+			more.SymbolsAfter = 1
+
+			if bits, err := g.estimateEntropy(more); err == nil && bits >= g.opt.MinEntropy {
+				opt.SymbolsAfter++
+				continue
+			}
+		}
+
+		if opt.WordCount >= maxTargetWords {
+			return optionError("MinEntropy", g.opt.MinEntropy, ErrConflict, "cannot be reached with these options")
+		}
+
+		opt.WordCount++
+	}
+
+	g.opt.WordCount = opt.WordCount
+	g.opt.DigitsAfter = opt.DigitsAfter
+	g.opt.SymbolsAfter = opt.SymbolsAfter
+
+	return nil
+}
+
+// Estimate the lowest entropy of the passwords generated with `opt`
+func (g *Generator) estimateEntropy(opt Options) (float64, error) {
+	est := Generator{opt: &opt, l33t: g.l33t, phrase: g.phrase}
+
+	// The words are not known yet. Count them as the shortest possible words,
+	// made of the letter with the least 1337 candidates. Padding is not accounted either
+	letter := string(g.l33t.weakestLetter())
+	if opt.Mode == ModeNumeric {
+		letter = "0"
+	}
+
+	minLen, err := est.minWordLength()
+	if err != nil {
+		return 0, err
+	}
+
+	words := make([][]rune, opt.WordCount)
+	for i := range words {
+		words[i] = []rune(strings.Repeat(letter, int(minLen)))
+	}
+
+	report, err := est.entropy(words, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	if opt.MaxLength == 0 {
+		return report.Bits, nil
+	}
+
+	// With `MaxLength`, the longer the first words, the shorter the next ones can be
+	budget, err := est.letterBudget()
+	if err != nil {
+		return 0, err
+	}
+
+	longest := make([][]rune, 0, opt.WordCount)
+	for range words {
+		if opt.Mode == ModePhrase {
+			longest = append(longest, make([]rune, g.phrase.maxLength(budget, longest)))
+		} else {
+			longest = append(longest, make([]rune, wordMaxLength(&opt, budget, minLen, longest)))
+		}
+	}
+
+	bits, err := est.wordsEntropy(longest)
+	if err != nil {
+		return 0, err
+	}
+
+	return report.Bits - report.Get(EntropyWords) + math.Min(bits, report.Get(EntropyWords)), nil
+}
@@ -0,0 +1,326 @@
+package mempass
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Kind of a token of a pattern
+type tokenKind int
+
+const (
+	tokenLiteral   tokenKind = iota // Any other character, or an escaped character such as `\9`
+	tokenWord                       // `word`, `Word`, `WORD`, or with lengths `{word:5}` and `{word:4-6}`: a dictionary word. Also `{adj}`, `{Noun}`...: a word of a part of speech
+	tokenConsonant                  // `c` or `C`: a random consonant
+	tokenVowel                      // `v` or `V`: a random vowel
+	tokenDigit                      // `9`: a random digit
+	tokenSymbol                     // `!`: a random symbol of `SymbolPool`
+)
+
+// Case of a word token
+type wordCase int
+
+const (
+	caseLower wordCase = iota
+	caseTitle
+	caseUpper
+)
+
+// Names of the word tokens, by case
+var patternWords = map[string]wordCase{"word": caseLower, "Word": caseTitle, "WORD": caseUpper}
+
+// Names of the part of speech tokens, in lowercase
+var patternParts = map[string]PartOfSpeech{
+	"adj": PosAdjective, "adjective": PosAdjective, "noun": PosNoun, "plural_noun": PosPluralNoun,
+	"verb": PosVerb, "adv": PosAdverb, "adverb": PosAdverb,
+}
+
+// Part of speech and case of a part of speech token, e.g. `Noun` is a noun in title case
+func patternPart(name string) (PartOfSpeech, wordCase, bool) {
+	pos, exists := patternParts[strings.ToLower(name)]
+	if !exists {
+		return "", 0, false
+	}
+
+	switch name {
+	case strings.ToLower(name):
+		return pos, caseLower, true
+	case strings.ToUpper(name):
+		return pos, caseUpper, true
+	case strings.ToUpper(name[:1]) + strings.ToLower(name[1:]):
+		return pos, caseTitle, true
+	}
+
+	return "", 0, false
+}
+
+type patternToken struct {
+	kind     tokenKind
+	char     rune     // Character of a literal
+	upper    bool     // Uppercase consonant or vowel
+	wordCase wordCase // Case of a word
+	words    []string // Words a word is picked among, sorted by length
+}
+
+// A compiled `Pattern`
+type pattern struct {
+	tokens []patternToken
+	minLen uint // Length of the shortest password
+	maxLen uint // Length of the longest password
+}
+
+// Compile `Pattern` into tokens. The words of each word token are looked up once and for all
+func (g *Generator) compilePattern() (*pattern, error) {
+	p := &pattern{}
+	runes := toRunes(g.opt.Pattern)
+
+	invalid := func(i int, err error, reason string) error {
+		return optionError("Pattern", g.opt.Pattern, err, fmt.Sprintf("%s at position %d", reason, i+1))
+	}
+
+	for i := 0; i < len(runes); i++ {
+		token := patternToken{kind: tokenLiteral, char: runes[i]}
+
+		switch runes[i] {
+		case '\\':
+			if i+1 == len(runes) {
+				return nil, invalid(i, ErrUnsupportedValue, "ends with an escape character")
+			}
+
+			i++
+			token.char = runes[i]
+
+		case '{':
+			end := slices.Index(runes[i:], '}')
+			if end < 0 {
+				return nil, invalid(i, ErrUnsupportedValue, "has an unclosed `{`")
+			}
+
+			name, lengths, hasLengths := strings.Cut(string(runes[i+1:i+end]), ":")
+
+			wc, isWord := patternWords[name]
+			pos, posCase, isPart := patternPart(name)
+			if !isWord && !isPart {
+				return nil, invalid(i, ErrUnsupportedValue, "has an unknown token `{"+name+"}`")
+			}
+
+			// Like in phrase mode, the words of a part of speech are not limited by the word lengths of the options
+			minLen, maxLen := g.opt.MinWordLength, g.opt.MaxWordLength
+			if isPart {
+				minLen, maxLen = 1, 28
+			}
+
+			var err error
+			if hasLengths {
+				if minLen, maxLen, err = parseLengths(lengths); err != nil {
+					return nil, invalid(i, ErrOutOfRange, err.Error())
+				}
+			}
+
+			if isWord {
+				token, err = g.wordToken(wc, minLen, maxLen)
+			} else {
+				token, err = g.partToken(pos, posCase, minLen, maxLen)
+			}
+
+			// Errors of the tagged dictionary are about other options
+			var optErr *OptionError
+			if errors.As(err, &optErr) {
+				return nil, err
+			} else if err != nil {
+				return nil, invalid(i, ErrConflict, err.Error())
+			}
+
+			i += end
+
+		case 'c', 'C':
+			token = patternToken{kind: tokenConsonant, upper: unicode.IsUpper(runes[i])}
+
+		case 'v', 'V':
+			token = patternToken{kind: tokenVowel, upper: unicode.IsUpper(runes[i])}
+
+		case '9':
+			token = patternToken{kind: tokenDigit}
+
+		case '!':
+			token = patternToken{kind: tokenSymbol}
+
+		default:
+			if i+4 > len(runes) {
+				break
+			}
+
+			if wc, exists := patternWords[string(runes[i:i+4])]; exists {
+				var err error
+				if token, err = g.wordToken(wc, g.opt.MinWordLength, g.opt.MaxWordLength); err != nil {
+					return nil, invalid(i, ErrConflict, err.Error())
+				}
+
+				i += 3
+			}
+		}
+
+		p.tokens = append(p.tokens, token)
+
+		if token.kind == tokenWord {
+			p.minLen += uint(len(toRunes(token.words[0])))
+			p.maxLen += uint(len(toRunes(token.words[len(token.words)-1])))
+		} else {
+			p.minLen++
+			p.maxLen++
+		}
+	}
+
+	return p, nil
+}
+
+// Parse the lengths of a word token, `5` or `4-6`
+func parseLengths(s string) (uint, uint, error) {
+	from, to, isRange := strings.Cut(s, "-")
+	if !isRange {
+		to = from
+	}
+
+	minLen, minErr := strconv.ParseUint(from, 10, 8)
+	maxLen, maxErr := strconv.ParseUint(to, 10, 8)
+
+	switch {
+	case minErr != nil || maxErr != nil:
+		return 0, 0, fmt.Errorf("has invalid word lengths `%s`", s)
+	case minLen == 0 || maxLen > 28 || minLen > maxLen:
+		return 0, 0, fmt.Errorf("has word lengths `%s` out of the 1 to 28 range", s)
+	}
+
+	return uint(minLen), uint(maxLen), nil
+}
+
+// Create a word token with the dictionary words between `minLen` and `maxLen` letters
+func (g *Generator) wordToken(wc wordCase, minLen, maxLen uint) (patternToken, error) {
+	index, err := getDictIndex(g.opt)
+	if err != nil {
+		return patternToken{}, err
+	}
+
+	words := index.lookup(minLen, maxLen)
+	if len(words) == 0 {
+		return patternToken{}, errors.New("has a word token that no dictionary word matches")
+	}
+
+	return patternToken{kind: tokenWord, wordCase: wc, words: words}, nil
+}
+
+// Create a word token with the words of a part of speech between `minLen` and `maxLen` letters
+func (g *Generator) partToken(pos PartOfSpeech, wc wordCase, minLen, maxLen uint) (patternToken, error) {
+	dict, err := g.taggedDictionary()
+	if err != nil {
+		return patternToken{}, err
+	}
+
+	var words []string
+	for _, word := range dict.tags[pos] {
+		if l := uint(len(toRunes(word))); l >= minLen && l <= maxLen {
+			words = append(words, word)
+		}
+	}
+
+	if len(words) == 0 {
+		return patternToken{}, errors.New("has a part of speech token that no word of the dictionary matches")
+	}
+
+	slices.SortStableFunc(words, func(a, b string) int { return len(toRunes(a)) - len(toRunes(b)) })
+
+	return patternToken{kind: tokenWord, wordCase: wc, words: words}, nil
+}
+
+// Check if the pattern has a token of a kind
+func (p *pattern) has(kind tokenKind) bool {
+	return slices.ContainsFunc(p.tokens, func(t patternToken) bool { return t.kind == kind })
+}
+
+// Generate a password from the pattern
+func (g *Generator) genPattern() []rune {
+	var pwd []rune
+
+	for _, t := range g.pattern.tokens {
+		switch t.kind {
+		case tokenLiteral:
+			pwd = append(pwd, t.char)
+
+		case tokenWord:
+			word := toRunes(t.words[g.rnd.Intn(len(t.words))])
+
+			switch t.wordCase {
+			case caseTitle:
+				word[0] = unicode.ToUpper(word[0])
+			case caseUpper:
+				word = g.arrayMap(word, g.capChar)
+			}
+
+			pwd = append(pwd, word...)
+
+		case tokenConsonant, tokenVowel:
+			pool := CONSONANTS
+			if t.kind == tokenVowel {
+				pool = VOWELS
+			}
+
+			letter := g.randBytesFrom(1, pool)[0]
+			if t.upper {
+				letter = unicode.ToUpper(letter)
+			}
+
+			pwd = append(pwd, letter)
+
+		case tokenDigit:
+			pwd = append(pwd, g.randBytesFrom(1, NUMBERS)...)
+
+		case tokenSymbol:
+			pwd = append(pwd, g.randBytesFrom(1, g.opt.SymbolPool)...)
+		}
+	}
+
+	return pwd
+}
+
+// Entropy of the passwords generated from the pattern. It is the same for every password
+func (g *Generator) patternEntropy() *EntropyReport {
+	bits := make(map[EntropySource]float64)
+
+	for _, t := range g.pattern.tokens {
+		switch t.kind {
+		case tokenWord:
+			bits[EntropyWords] += math.Log2(float64(len(t.words)))
+		case tokenConsonant:
+			bits[EntropyLetters] += math.Log2(float64(len(CONSONANTS)))
+		case tokenVowel:
+			bits[EntropyLetters] += math.Log2(float64(len(VOWELS)))
+		case tokenDigit:
+			bits[EntropyDigits] += math.Log2(10)
+		case tokenSymbol:
+			bits[EntropySymbols] += poolEntropy(g.opt.SymbolPool)
+		}
+	}
+
+	report := &EntropyReport{}
+
+	for _, source := range []EntropySource{EntropyWords, EntropyLetters, EntropyDigits, EntropySymbols} {
+		if b, exists := bits[source]; exists {
+			report.add(source, b)
+		}
+	}
+
+	if g.pattern.has(tokenSymbol) && poolEntropy(g.opt.SymbolPool) == 0 {
+		report.warn("The symbol is always the same and adds no entropy")
+	}
+
+	if report.Bits == 0 {
+		report.warn("The pattern has no random token and adds no entropy")
+	}
+
+	return report
+}
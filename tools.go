@@ -3,6 +3,8 @@ package mempass
 const ALPHABET_LOWER = "abcdefghijklmnopqrstuvwxyz"
 const ALPHABET_UPPER = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
 const NUMBERS = "0123456789"
+const CONSONANTS = "bcdfghjklmnpqrstvwxyz"
+const VOWELS = "aeiou"
 
 func toRunes(s string) []rune {
 	return []rune(s)